@@ -8,6 +8,9 @@ import (
 
 type ArrayList struct {
 	slice []interface{}
+	// modCount is the number of structural modifications of this list: changes in its size, and
+	// reorderings such as Sort. Iterators and sublists use it to detect modifications made outside of them.
+	modCount int
 }
 
 // New returns a new *ArrayList
@@ -18,6 +21,7 @@ func New() *ArrayList {
 // Add appends the specified elements to the end of this list.
 func (a *ArrayList) Add(objs ...interface{}) {
 	a.slice = append(a.slice, objs...)
+	a.modCount++
 }
 
 // AddAt inserts the specified elements at the specified position in this list.
@@ -45,11 +49,29 @@ func (a *ArrayList) AddAt(pos int, objs ...interface{}) error {
 // AddFirst inserts the specified elements to the beginning of this list.
 func (a *ArrayList) AddFirst(objs ...interface{}) {
 	a.slice = append(objs, a.slice...)
+	a.modCount++
 }
 
 // Clear removes all of the elements from this list.
 func (a *ArrayList) Clear() {
 	a.slice = nil
+	a.modCount++
+}
+
+// Contains returns true if this list contains the specified element.
+func (a *ArrayList) Contains(obj interface{}) bool {
+	return a.IndexOf(obj) > -1
+}
+
+// ContainsAll returns true if this list contains all of the specified elements.
+func (a *ArrayList) ContainsAll(objs ...interface{}) bool {
+	for _, obj := range objs {
+		if !a.Contains(obj) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Get returns the element at the specified position in this list.
@@ -113,9 +135,98 @@ func (a *ArrayList) RemoveAt(pos int) error {
 
 	a.slice[pos] = nil
 	a.slice = append(a.slice[:pos], a.slice[pos+1:]...)
+	a.modCount++
 	return nil
 }
 
+// RemoveAll removes from this list all of its elements that are equal to any of the specified elements.
+// It returns the number of removed elements.
+func (a *ArrayList) RemoveAll(objs ...interface{}) int {
+	return a.RemoveIf(func(obj interface{}) bool {
+		return containsElement(objs, obj)
+	})
+}
+
+// RemoveIf removes all of the elements of this list that satisfy the given predicate.
+// The predicate is applied to every element before any of them is removed, so this list is left
+// unchanged if it panics. If predicate is nil, nothing is removed.
+// It returns the number of removed elements.
+func (a *ArrayList) RemoveIf(predicate func(obj interface{}) bool) int {
+	if predicate == nil {
+		return 0
+	}
+
+	keep := make([]bool, a.Size())
+	removed := 0
+	for i, o := range a.slice {
+		if keep[i] = !predicate(o); !keep[i] {
+			removed++
+		}
+	}
+
+	if removed == 0 {
+		return 0
+	}
+
+	kept := a.slice[:0]
+	for i, o := range a.slice {
+		if keep[i] {
+			kept = append(kept, o)
+		}
+	}
+
+	clear(a.slice[len(kept):])
+	a.slice = kept
+	a.modCount++
+	return removed
+}
+
+// RemoveRange removes from this list all of the elements whose index is between from, inclusive, and to, exclusive.
+// If from or to are out of the list bounds, or from is greater than to, then index out of range error is returned.
+func (a *ArrayList) RemoveRange(from, to int) error {
+	if err := a.checkBounds(from, to); err != nil {
+		return err
+	}
+
+	if from == to {
+		return nil
+	}
+
+	size := a.Size()
+	a.slice = append(a.slice[:from], a.slice[to:]...)
+	clear(a.slice[len(a.slice):size])
+	a.modCount++
+	return nil
+}
+
+// ReplaceAll replaces each element of this list with the result of applying replaceFunc to it.
+func (a *ArrayList) ReplaceAll(replaceFunc func(obj interface{}) interface{}) {
+	for i, o := range a.slice {
+		a.slice[i] = replaceFunc(o)
+	}
+}
+
+// RetainAll removes from this list all of its elements that are not equal to any of the specified elements.
+// It returns the number of removed elements.
+func (a *ArrayList) RetainAll(objs ...interface{}) int {
+	return a.RemoveIf(func(obj interface{}) bool {
+		return !containsElement(objs, obj)
+	})
+}
+
+// Set replaces the element at the specified position in this list with the specified element.
+// It returns the element previously at the specified position.
+// It can return index out of range error.
+func (a *ArrayList) Set(pos int, obj interface{}) (interface{}, error) {
+	if err := a.checkRange(pos); err != nil {
+		return nil, err
+	}
+
+	previous := a.slice[pos]
+	a.slice[pos] = obj
+	return previous, nil
+}
+
 // Size returns the number of elements in this list.
 func (a *ArrayList) Size() int {
 	return len(a.slice)
@@ -129,6 +240,19 @@ func (a *ArrayList) Slice() []interface{} {
 
 func (a *ArrayList) addAt(pos int, elements ...interface{}) {
 	a.slice = append(append(append([]interface{}{}, a.slice[:pos]...), elements...), a.slice[pos:]...)
+	a.modCount++
+}
+
+func (a *ArrayList) checkBounds(from, to int) error {
+	if err := a.checkRangeForAddAt(from); err != nil {
+		return err
+	}
+
+	if to > a.Size() || to < from {
+		return indexOutOfRangeErr(to, a.Size())
+	}
+
+	return nil
 }
 
 func (a *ArrayList) checkRangeForAddAt(pos int) error {
@@ -147,6 +271,16 @@ func (a *ArrayList) checkRange(pos int) error {
 	return nil
 }
 
+func containsElement(objs []interface{}, obj interface{}) bool {
+	for _, o := range objs {
+		if reflect.DeepEqual(o, obj) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func elementNotFoundErr(obj interface{}) error {
 	errStr := fmt.Sprintf("%v element was not found in this list.", obj)
 	return errors.New(errStr)
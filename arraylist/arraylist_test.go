@@ -2,6 +2,7 @@ package arraylist
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -179,6 +180,31 @@ func TestClear(t *testing.T) {
 	}
 }
 
+func TestContains(t *testing.T) {
+	list := new(ArrayList)
+	list.Add("a", []int{1, 2}, nil)
+
+	if !list.Contains([]int{1, 2}) {
+		t.Error("ArrayList should contain [1 2]")
+	}
+
+	if list.Contains("b") {
+		t.Error("ArrayList should not contain 'b'")
+	}
+
+	if !list.ContainsAll("a", nil) {
+		t.Error("ArrayList should contain 'a' and nil")
+	}
+
+	if list.ContainsAll("a", "b") {
+		t.Error("ArrayList should not contain 'a' and 'b'")
+	}
+
+	if !list.ContainsAll() {
+		t.Error("ArrayList should contain all of no elements")
+	}
+}
+
 func TestGet(t *testing.T) {
 	list := new(ArrayList)
 	for i := 0; i < 10; i++ {
@@ -299,6 +325,147 @@ func TestRemoveAt(t *testing.T) {
 	}
 }
 
+func TestRemoveAll(t *testing.T) {
+	list := new(ArrayList)
+	list.Add("a", "b", "a", "c", 1)
+
+	if removed := list.RemoveAll("a", 1, "z"); removed != 3 {
+		t.Errorf("RemoveAll should remove 3 elements, but removed %d", removed)
+	}
+
+	if expect := []interface{}{"b", "c"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	list := new(ArrayList)
+	list.Add(1, 2, 3, 4, 5, 6)
+
+	removed := list.RemoveIf(func(obj interface{}) bool {
+		return obj.(int)%2 == 0
+	})
+
+	if removed != 3 {
+		t.Errorf("RemoveIf should remove 3 elements, but removed %d", removed)
+	}
+
+	if expect := []interface{}{1, 3, 5}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if removed = list.RemoveIf(func(obj interface{}) bool { return false }); removed != 0 {
+		t.Errorf("RemoveIf should remove 0 elements, but removed %d", removed)
+	}
+}
+
+func TestRemoveIf_Panic(t *testing.T) {
+	list := new(ArrayList)
+	list.Add(1, 2, 3)
+
+	func() {
+		defer func() { recover() }()
+		list.RemoveIf(func(obj interface{}) bool {
+			if obj.(int) == 3 {
+				panic("predicate failed")
+			}
+			return obj.(int) == 1
+		})
+	}()
+
+	if expect := []interface{}{1, 2, 3}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if removed := list.RemoveIf(nil); removed != 0 {
+		t.Errorf("RemoveIf should remove 0 elements, but removed %d", removed)
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	list := new(ArrayList)
+	list.Add("a", "b", "c", "d", "e")
+
+	if err := list.RemoveRange(1, 3); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if expect := []interface{}{"a", "d", "e"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if err := list.RemoveRange(3, 3); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	for _, r := range [][2]int{{-1, 2}, {0, 4}, {2, 1}, {4, 4}} {
+		err := list.RemoveRange(r[0], r[1])
+		if err == nil {
+			t.Errorf("Error should be index out of range for %v", r)
+		}
+	}
+
+	if size := list.Size(); size != 3 {
+		t.Errorf("ArrayList should have a size of 3, but has %d", size)
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	list := new(ArrayList)
+	list.Add(1, 2, 3)
+
+	list.ReplaceAll(func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	})
+
+	if expect := []interface{}{2, 4, 6}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+}
+
+func TestRetainAll(t *testing.T) {
+	list := new(ArrayList)
+	list.Add("a", "b", "a", "c")
+
+	if removed := list.RetainAll("a", "c"); removed != 1 {
+		t.Errorf("RetainAll should remove 1 element, but removed %d", removed)
+	}
+
+	if expect := []interface{}{"a", "a", "c"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if removed := list.RetainAll(); removed != 3 {
+		t.Errorf("RetainAll should remove 3 elements, but removed %d", removed)
+	}
+
+	if !list.IsEmpty() {
+		t.Errorf("ArrayList should be empty, but has %d elements", list.Size())
+	}
+}
+
+func TestSet(t *testing.T) {
+	list := new(ArrayList)
+	list.Add("a", "b")
+
+	previous, err := list.Set(1, "c")
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if previous != "b" {
+		t.Errorf("Previous element should be 'b', but was '%v'", previous)
+	}
+
+	if el, _ := list.Get(1); el != "c" {
+		t.Errorf("Element should be 'c', but was '%v'", el)
+	}
+
+	if _, err = list.Set(2, "d"); err == nil {
+		t.Error("Error should be index out of range")
+	}
+}
+
 func TestSlice(t *testing.T) {
 	list := new(ArrayList)
 	for i := 0; i < 10; i++ {
@@ -307,7 +474,7 @@ func TestSlice(t *testing.T) {
 
 	listArray := list.Slice()
 	if len(listArray) != list.Size() {
-		t.Errorf("New Slice size should be %d, but was", list.Size(), len(listArray))
+		t.Errorf("New Slice size should be %d, but was %d", list.Size(), len(listArray))
 	}
 
 	listArray[0] = "New Element"
@@ -0,0 +1,158 @@
+package arraylist
+
+import (
+	"reflect"
+)
+
+// ArrayListOf is the type-parameterized counterpart of ArrayList.
+// It keeps the same semantics and errors, but its elements are typed, so no
+// type assertions are needed after Get or Slice.
+type ArrayListOf[T any] struct {
+	slice []T
+}
+
+// NewOf returns a new *ArrayListOf[T]
+func NewOf[T any]() *ArrayListOf[T] {
+	return new(ArrayListOf[T])
+}
+
+// Add appends the specified elements to the end of this list.
+func (a *ArrayListOf[T]) Add(objs ...T) {
+	a.slice = append(a.slice, objs...)
+}
+
+// AddAt inserts the specified elements at the specified position in this list.
+// If pos is more than the list size or less than 0, then index out of range
+// error is returned. Nil otherwise.
+func (a *ArrayListOf[T]) AddAt(pos int, objs ...T) error {
+	if pos > a.Size() || pos < 0 {
+		return indexOutOfRangeErr(pos, a.Size())
+	}
+
+	switch pos {
+	case 0:
+		a.AddFirst(objs...)
+	case a.Size():
+		a.Add(objs...)
+	default:
+		a.slice = append(append(append([]T{}, a.slice[:pos]...), objs...), a.slice[pos:]...)
+	}
+
+	return nil
+}
+
+// AddFirst inserts the specified elements to the beginning of this list.
+func (a *ArrayListOf[T]) AddFirst(objs ...T) {
+	a.slice = append(append([]T{}, objs...), a.slice...)
+}
+
+// Clear removes all of the elements from this list.
+func (a *ArrayListOf[T]) Clear() {
+	a.slice = nil
+}
+
+// Get returns the element at the specified position in this list.
+// It returns the element at the specified position if exists, otherwise returns the zero value of T.
+// Can return index out of range error.
+func (a *ArrayListOf[T]) Get(pos int) (T, error) {
+	if err := a.checkRange(pos); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return a.slice[pos], nil
+}
+
+// IndexOf returns the index (0-based) of the first occurrence of the specified element in this list.
+// It can return -1 if this list does not contain the specified element.
+// Elements are compared with reflect.DeepEqual; see IndexOfComparable for a faster alternative.
+func (a *ArrayListOf[T]) IndexOf(obj T) int {
+	for i, o := range a.slice {
+		if reflect.DeepEqual(o, obj) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IsEmpty returns true if this list containes no elements.
+func (a *ArrayListOf[T]) IsEmpty() bool {
+	return a.Size() == 0
+}
+
+// LastIndexOf returns the index (0-based) of the last occurrence of the specified element in this list.
+// It can return -1 if this list does not contain the specified element.
+func (a *ArrayListOf[T]) LastIndexOf(obj T) int {
+	for i := a.Size() - 1; i > -1; i-- {
+		if reflect.DeepEqual(a.slice[i], obj) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Remove removes the first occurrence of the specified element from this list.
+// If element not found, it returns an element not found error.
+func (a *ArrayListOf[T]) Remove(obj T) error {
+	if i := a.IndexOf(obj); i > -1 {
+		return a.RemoveAt(i)
+	}
+
+	return elementNotFoundErr(obj)
+}
+
+// RemoveAt removes the element at the specified position (0-based) in this list.
+// It can return index out of range error.
+func (a *ArrayListOf[T]) RemoveAt(pos int) error {
+	if err := a.checkRange(pos); err != nil {
+		return err
+	}
+
+	var zero T
+	a.slice[pos] = zero
+	a.slice = append(a.slice[:pos], a.slice[pos+1:]...)
+	return nil
+}
+
+// Size returns the number of elements in this list.
+func (a *ArrayListOf[T]) Size() int {
+	return len(a.slice)
+}
+
+// Slice returns a slice containing all of the elements in this list.
+// To avoid references, the returned slice is a copy of this list.
+func (a *ArrayListOf[T]) Slice() []T {
+	return append([]T{}, a.slice...)
+}
+
+// IndexOfComparable works like IndexOf, but compares elements with == instead of
+// reflect.DeepEqual. It is only available for lists of comparable elements.
+func IndexOfComparable[T comparable](a *ArrayListOf[T], obj T) int {
+	for i, o := range a.slice {
+		if o == obj {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// RemoveComparable works like Remove, but compares elements with == instead of
+// reflect.DeepEqual. It is only available for lists of comparable elements.
+func RemoveComparable[T comparable](a *ArrayListOf[T], obj T) error {
+	if i := IndexOfComparable(a, obj); i > -1 {
+		return a.RemoveAt(i)
+	}
+
+	return elementNotFoundErr(obj)
+}
+
+func (a *ArrayListOf[T]) checkRange(pos int) error {
+	if pos > a.Size()-1 || pos < 0 {
+		return indexOutOfRangeErr(pos, a.Size())
+	}
+
+	return nil
+}
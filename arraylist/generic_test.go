@@ -0,0 +1,138 @@
+package arraylist
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testItem struct {
+	Name string
+	Tags []string
+}
+
+func TestArrayListOf_Add(t *testing.T) {
+	list := NewOf[string]()
+
+	list.Add("First Element")
+	list.Add("Second Element", "Third Element")
+
+	if size := list.Size(); size != 3 {
+		t.Errorf("ArrayListOf should have a size of 3, but has %d", size)
+	}
+
+	if obj, _ := list.Get(2); obj != "Third Element" {
+		t.Errorf("ArrayListOf 2nd element should be 'Third Element', but was '%s'", obj)
+	}
+}
+
+func TestArrayListOf_AddAt(t *testing.T) {
+	list := NewOf[int]()
+	list.Add(1, 2, 5)
+
+	if err := list.AddAt(2, 3, 4); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	list.AddAt(0, 0)
+	list.AddAt(list.Size(), 6)
+
+	for i := 0; i < list.Size(); i++ {
+		if obj, _ := list.Get(i); obj != i {
+			t.Errorf("ArrayListOf element %d should be %d, but was %d", i, i, obj)
+		}
+	}
+
+	if err := list.AddAt(20, 7); err == nil {
+		t.Error("Error should be index out of range")
+	}
+
+	if err := list.AddAt(-1, 7); err == nil {
+		t.Error("Error should be index out of range")
+	}
+}
+
+func TestArrayListOf_Get(t *testing.T) {
+	list := NewOf[int]()
+	list.Add(10)
+
+	obj, err := list.Get(1)
+	if obj != 0 {
+		t.Errorf("Element should be 0, but was %d", obj)
+	}
+
+	if err == nil {
+		t.Error("Error should be index out of range")
+	}
+}
+
+func TestArrayListOf_IndexOf(t *testing.T) {
+	list := NewOf[testItem]()
+	list.Add(testItem{"a", []string{"x"}}, testItem{"b", nil}, testItem{"a", []string{"x"}})
+
+	if i := list.IndexOf(testItem{"a", []string{"x"}}); i != 0 {
+		t.Errorf("Index should be 0, but was %d", i)
+	}
+
+	if i := list.LastIndexOf(testItem{"a", []string{"x"}}); i != 2 {
+		t.Errorf("Last index should be 2, but was %d", i)
+	}
+
+	if i := list.IndexOf(testItem{"c", nil}); i != -1 {
+		t.Errorf("Index should be -1, but was %d", i)
+	}
+}
+
+func TestArrayListOf_Remove(t *testing.T) {
+	list := NewOf[string]()
+	for i := 0; i < 10; i++ {
+		list.Add(fmt.Sprintf("Element %d", i))
+	}
+
+	if err := list.Remove("Element 3"); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if err := list.Remove("Element 3"); err == nil {
+		t.Error("Error should be element not found")
+	}
+
+	if err := list.RemoveAt(9); err == nil {
+		t.Error("Error should be index out of range")
+	}
+
+	if size := list.Size(); size != 9 {
+		t.Errorf("ArrayListOf should have a size of 9, but has %d", size)
+	}
+}
+
+func TestArrayListOf_Comparable(t *testing.T) {
+	list := NewOf[string]()
+	list.Add("a", "b", "c", "b")
+
+	if i := IndexOfComparable(list, "b"); i != 1 {
+		t.Errorf("Index should be 1, but was %d", i)
+	}
+
+	if err := RemoveComparable(list, "b"); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if i := IndexOfComparable(list, "b"); i != 2 {
+		t.Errorf("Index should be 2, but was %d", i)
+	}
+
+	if err := RemoveComparable(list, "z"); err == nil {
+		t.Error("Error should be element not found")
+	}
+}
+
+func TestArrayListOf_Slice(t *testing.T) {
+	list := NewOf[string]()
+	list.Add("Element 0", "Element 1")
+
+	slice := list.Slice()
+	slice[0] = "New Element"
+	if obj, _ := list.Get(0); obj == "New Element" {
+		t.Errorf("%s should be different from 'New Element'", obj)
+	}
+}
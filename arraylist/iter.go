@@ -0,0 +1,83 @@
+package arraylist
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the positions and elements of this list, from first to last.
+// Elements added or removed during the iteration are taken into account.
+func (a *ArrayList) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i := 0; i < a.Size(); i++ {
+			if !yield(i, a.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the positions and elements of this list, from last to first.
+func (a *ArrayList) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i := a.Size() - 1; i > -1; i-- {
+			if i >= a.Size() {
+				continue
+			}
+
+			if !yield(i, a.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of this list, from first to last.
+// Unlike Slice, no copy of this list is made.
+func (a *ArrayList) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, obj := range a.All() {
+			if !yield(obj) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the positions and elements of this list, from first to last.
+// Elements added or removed during the iteration are taken into account.
+func (a *ArrayListOf[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < a.Size(); i++ {
+			if !yield(i, a.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the positions and elements of this list, from last to first.
+func (a *ArrayListOf[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := a.Size() - 1; i > -1; i-- {
+			if i >= a.Size() {
+				continue
+			}
+
+			if !yield(i, a.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of this list, from first to last.
+// Unlike Slice, no copy of this list is made.
+func (a *ArrayListOf[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, obj := range a.All() {
+			if !yield(obj) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package arraylist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	positions, elements := make([]int, 0), make([]interface{}, 0)
+	for i, obj := range list.All() {
+		positions = append(positions, i)
+		elements = append(elements, obj)
+	}
+
+	if expect := []int{0, 1, 2}; !reflect.DeepEqual(positions, expect) {
+		t.Errorf("%v is not equal to %v", positions, expect)
+	}
+
+	if expect := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(elements, expect) {
+		t.Errorf("%v is not equal to %v", elements, expect)
+	}
+
+	count := 0
+	for i := range list.All() {
+		if i == 1 {
+			list.RemoveAt(i)
+		}
+
+		count++
+	}
+
+	if count != 2 {
+		t.Errorf("Iteration should visit 2 elements after removing one, but visited %d", count)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	elements := make([]interface{}, 0)
+	for _, obj := range list.Backward() {
+		elements = append(elements, obj)
+	}
+
+	if expect := []interface{}{"c", "b", "a"}; !reflect.DeepEqual(elements, expect) {
+		t.Errorf("%v is not equal to %v", elements, expect)
+	}
+
+	for i := range list.Backward() {
+		list.RemoveAt(i)
+	}
+
+	if !list.IsEmpty() {
+		t.Errorf("ArrayList should be empty, but has %d elements", list.Size())
+	}
+}
+
+func TestValues(t *testing.T) {
+	list := New()
+	list.Add(1, 2, 3)
+
+	elements := make([]interface{}, 0)
+	for obj := range list.Values() {
+		if obj == 3 {
+			break
+		}
+
+		elements = append(elements, obj)
+	}
+
+	if expect := []interface{}{1, 2}; !reflect.DeepEqual(elements, expect) {
+		t.Errorf("%v is not equal to %v", elements, expect)
+	}
+}
+
+func TestArrayListOf_Iterators(t *testing.T) {
+	list := NewOf[string]()
+	list.Add("a", "b", "c")
+
+	forward := make([]string, 0)
+	for obj := range list.Values() {
+		forward = append(forward, obj)
+	}
+
+	if expect := []string{"a", "b", "c"}; !reflect.DeepEqual(forward, expect) {
+		t.Errorf("%v is not equal to %v", forward, expect)
+	}
+
+	backward := make([]string, 0)
+	for _, obj := range list.Backward() {
+		backward = append(backward, obj)
+	}
+
+	if expect := []string{"c", "b", "a"}; !reflect.DeepEqual(backward, expect) {
+		t.Errorf("%v is not equal to %v", backward, expect)
+	}
+}
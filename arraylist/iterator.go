@@ -0,0 +1,162 @@
+package arraylist
+
+import (
+	"errors"
+)
+
+var (
+	ConcurrentModificationErr = errors.New("list was modified outside of the iterator.")
+	NoSuchElementErr          = errors.New("iteration has no more elements.")
+	IllegalStateErr           = errors.New("Next or Previous have not been called, or Remove or Add were called after the last call to them.")
+)
+
+// ListIterator iterates over an ArrayList in either direction, and can modify the list during the iteration.
+// It is fail-fast: if the list is structurally modified (elements added or removed) by anything other
+// than the iterator's own Remove and Add methods, the next call to the iterator returns
+// ConcurrentModificationErr.
+//
+//	it := list.ListIterator()
+//	for it.HasNext() {
+//		obj, _ := it.Next()
+//		if obj == nil {
+//			it.Remove()
+//		}
+//	}
+type ListIterator struct {
+	list             *ArrayList
+	cursor           int
+	lastReturned     int
+	expectedModCount int
+}
+
+// ListIterator returns a *ListIterator over the elements of this list, starting at the beginning of it.
+func (a *ArrayList) ListIterator() *ListIterator {
+	it, _ := a.ListIteratorAt(0)
+	return it
+}
+
+// ListIteratorAt returns a *ListIterator over the elements of this list, starting at the specified position:
+// the first call to Next returns the element at pos, and the first call to Previous returns the element at pos - 1.
+// If pos is more than the list size or less than 0, then index out of range error is returned.
+func (a *ArrayList) ListIteratorAt(pos int) (*ListIterator, error) {
+	if err := a.checkRangeForAddAt(pos); err != nil {
+		return nil, err
+	}
+
+	return &ListIterator{
+		list:             a,
+		cursor:           pos,
+		lastReturned:     -1,
+		expectedModCount: a.modCount,
+	}, nil
+}
+
+// Add inserts the specified element into the list, just before the element that would be returned by Next.
+// A following call to Previous returns the new element.
+// It can return ConcurrentModificationErr.
+func (it *ListIterator) Add(obj interface{}) error {
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+
+	it.list.AddAt(it.cursor, obj)
+	it.cursor++
+	it.lastReturned = -1
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// HasNext returns true if the iteration has more elements going forward.
+func (it *ListIterator) HasNext() bool {
+	return it.cursor < it.list.Size()
+}
+
+// HasPrevious returns true if the iteration has more elements going backward.
+func (it *ListIterator) HasPrevious() bool {
+	return it.cursor > 0
+}
+
+// Next returns the next element in the list and moves the cursor forward.
+// It can return ConcurrentModificationErr or NoSuchElementErr.
+func (it *ListIterator) Next() (interface{}, error) {
+	if err := it.checkModification(); err != nil {
+		return nil, err
+	}
+
+	if !it.HasNext() {
+		return nil, NoSuchElementErr
+	}
+
+	it.lastReturned = it.cursor
+	it.cursor++
+	return it.list.slice[it.lastReturned], nil
+}
+
+// NextIndex returns the index of the element that would be returned by Next,
+// or the list size if the iterator is at the end of the list.
+func (it *ListIterator) NextIndex() int {
+	return it.cursor
+}
+
+// Previous returns the previous element in the list and moves the cursor backward.
+// It can return ConcurrentModificationErr or NoSuchElementErr.
+func (it *ListIterator) Previous() (interface{}, error) {
+	if err := it.checkModification(); err != nil {
+		return nil, err
+	}
+
+	if !it.HasPrevious() {
+		return nil, NoSuchElementErr
+	}
+
+	it.cursor--
+	it.lastReturned = it.cursor
+	return it.list.slice[it.lastReturned], nil
+}
+
+// PreviousIndex returns the index of the element that would be returned by Previous,
+// or -1 if the iterator is at the beginning of the list.
+func (it *ListIterator) PreviousIndex() int {
+	return it.cursor - 1
+}
+
+// Remove removes from the list the last element returned by Next or Previous.
+// It can return ConcurrentModificationErr or IllegalStateErr.
+func (it *ListIterator) Remove() error {
+	if it.lastReturned < 0 {
+		return IllegalStateErr
+	}
+
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+
+	it.list.RemoveAt(it.lastReturned)
+	it.cursor = it.lastReturned
+	it.lastReturned = -1
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// Set replaces the last element returned by Next or Previous with the specified element.
+// It can return ConcurrentModificationErr or IllegalStateErr.
+func (it *ListIterator) Set(obj interface{}) error {
+	if it.lastReturned < 0 {
+		return IllegalStateErr
+	}
+
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+
+	it.list.slice[it.lastReturned] = obj
+	return nil
+}
+
+func (it *ListIterator) checkModification() error {
+	if it.list.modCount != it.expectedModCount {
+		return ConcurrentModificationErr
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package arraylist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListIterator(t *testing.T) {
+	list := New()
+	list.Add(1, 2, 3, 4, 5)
+
+	it := list.ListIterator()
+	for it.HasNext() {
+		obj, err := it.Next()
+		if err != nil {
+			t.Fatalf("Error should be nil, but was %s", err.Error())
+		}
+
+		switch obj.(int) % 2 {
+		case 0:
+			it.Remove()
+		default:
+			it.Set(obj.(int) * 10)
+		}
+	}
+
+	if expect := []interface{}{10, 30, 50}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if _, err := it.Next(); err != NoSuchElementErr {
+		t.Errorf("Error should be %v, but was %v", NoSuchElementErr, err)
+	}
+
+	backward := make([]interface{}, 0)
+	for it.HasPrevious() {
+		obj, _ := it.Previous()
+		backward = append(backward, obj)
+	}
+
+	if expect := []interface{}{50, 30, 10}; !reflect.DeepEqual(backward, expect) {
+		t.Errorf("%v is not equal to %v", backward, expect)
+	}
+
+	if _, err := it.Previous(); err != NoSuchElementErr {
+		t.Errorf("Error should be %v, but was %v", NoSuchElementErr, err)
+	}
+}
+
+func TestListIterator_Add(t *testing.T) {
+	list := New()
+	list.Add("a", "c")
+
+	it := list.ListIterator()
+	it.Next()
+	if err := it.Add("b"); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if i := it.NextIndex(); i != 2 {
+		t.Errorf("Next index should be 2, but was %d", i)
+	}
+
+	if obj, _ := it.Next(); obj != "c" {
+		t.Errorf("Next element should be 'c', but was '%v'", obj)
+	}
+
+	if expect := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if err := it.Remove(); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if err := it.Remove(); err != IllegalStateErr {
+		t.Errorf("Error should be %v, but was %v", IllegalStateErr, err)
+	}
+
+	it.Add("d")
+	if err := it.Set("e"); err != IllegalStateErr {
+		t.Errorf("Error should be %v, but was %v", IllegalStateErr, err)
+	}
+}
+
+func TestListIteratorAt(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	it, err := list.ListIteratorAt(3)
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if i := it.PreviousIndex(); i != 2 {
+		t.Errorf("Previous index should be 2, but was %d", i)
+	}
+
+	if obj, _ := it.Previous(); obj != "c" {
+		t.Errorf("Previous element should be 'c', but was '%v'", obj)
+	}
+
+	if _, err = list.ListIteratorAt(4); err == nil {
+		t.Error("Error should be index out of range")
+	}
+}
+
+func TestListIterator_ConcurrentModification(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	it := list.ListIterator()
+	it.Next()
+
+	list.RemoveAt(0)
+	if _, err := it.Next(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	if err := it.Remove(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	it = list.ListIterator()
+	list.Add("d")
+	if err := it.Add("e"); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	it = list.ListIterator()
+	list.Clear()
+	if _, err := it.Previous(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+}
+
+func TestListIterator_Set(t *testing.T) {
+	list := New()
+	list.Add("a", "b")
+
+	it := list.ListIterator()
+	it.Next()
+
+	list.Set(0, "c")
+	list.RemoveIf(func(obj interface{}) bool { return false })
+	if err := it.Set("d"); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	list.RemoveAll("b")
+	if _, err := it.Next(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+}
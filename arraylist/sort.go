@@ -0,0 +1,123 @@
+package arraylist
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// BinarySearch searches for the specified element in this list, which must be sorted in
+// increasing order according to cmpFunc. cmpFunc returns a negative number when a < b,
+// a positive number when a > b and zero when a == b.
+// It returns the position where the element is found, or where it would be inserted to keep
+// this list sorted, and true if the element was found.
+func (a *ArrayList) BinarySearch(obj interface{}, cmpFunc func(a, b interface{}) int) (int, bool) {
+	return slices.BinarySearchFunc(a.slice, obj, cmpFunc)
+}
+
+// InsertSorted inserts the specified element into this list, which must be sorted in increasing
+// order according to cmpFunc, keeping it sorted. The element is inserted after any equal elements.
+// It returns the position where the element was inserted.
+func (a *ArrayList) InsertSorted(obj interface{}, cmpFunc func(a, b interface{}) int) int {
+	pos := sort.Search(a.Size(), func(i int) bool {
+		return cmpFunc(a.slice[i], obj) > 0
+	})
+
+	a.slice = slices.Insert(a.slice, pos, obj)
+	a.modCount++
+	return pos
+}
+
+// IsSorted returns true if this list is sorted in increasing order according to less.
+func (a *ArrayList) IsSorted(less func(a, b interface{}) bool) bool {
+	return sort.SliceIsSorted(a.slice, func(i, j int) bool {
+		return less(a.slice[i], a.slice[j])
+	})
+}
+
+// Sort sorts this list in place in increasing order according to less.
+// The sort is not guaranteed to be stable; see SortStable.
+// Sorting counts as a structural modification, so iterators created before it fail.
+func (a *ArrayList) Sort(less func(a, b interface{}) bool) {
+	sort.Slice(a.slice, func(i, j int) bool {
+		return less(a.slice[i], a.slice[j])
+	})
+	a.modCount++
+}
+
+// SortStable sorts this list in place in increasing order according to less, keeping the
+// original order of equal elements.
+// Sorting counts as a structural modification, so iterators created before it fail.
+func (a *ArrayList) SortStable(less func(a, b interface{}) bool) {
+	sort.SliceStable(a.slice, func(i, j int) bool {
+		return less(a.slice[i], a.slice[j])
+	})
+	a.modCount++
+}
+
+// BinarySearch searches for the specified element in this list, which must be sorted in
+// increasing order according to cmpFunc.
+// It returns the position where the element is found, or where it would be inserted to keep
+// this list sorted, and true if the element was found.
+func (a *ArrayListOf[T]) BinarySearch(obj T, cmpFunc func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(a.slice, obj, cmpFunc)
+}
+
+// InsertSorted inserts the specified element into this list, which must be sorted in increasing
+// order according to cmpFunc, keeping it sorted. The element is inserted after any equal elements.
+// It returns the position where the element was inserted.
+func (a *ArrayListOf[T]) InsertSorted(obj T, cmpFunc func(a, b T) int) int {
+	pos := sort.Search(a.Size(), func(i int) bool {
+		return cmpFunc(a.slice[i], obj) > 0
+	})
+
+	a.slice = slices.Insert(a.slice, pos, obj)
+	return pos
+}
+
+// IsSorted returns true if this list is sorted in increasing order according to less.
+func (a *ArrayListOf[T]) IsSorted(less func(a, b T) bool) bool {
+	return sort.SliceIsSorted(a.slice, func(i, j int) bool {
+		return less(a.slice[i], a.slice[j])
+	})
+}
+
+// Sort sorts this list in place in increasing order according to less.
+// The sort is not guaranteed to be stable; see SortStable.
+func (a *ArrayListOf[T]) Sort(less func(a, b T) bool) {
+	sort.Slice(a.slice, func(i, j int) bool {
+		return less(a.slice[i], a.slice[j])
+	})
+}
+
+// SortStable sorts this list in place in increasing order according to less, keeping the
+// original order of equal elements.
+func (a *ArrayListOf[T]) SortStable(less func(a, b T) bool) {
+	sort.SliceStable(a.slice, func(i, j int) bool {
+		return less(a.slice[i], a.slice[j])
+	})
+}
+
+// BinarySearchOrdered works like BinarySearch, but compares elements with their natural order.
+// It is only available for lists of ordered elements.
+func BinarySearchOrdered[T cmp.Ordered](a *ArrayListOf[T], obj T) (int, bool) {
+	return slices.BinarySearch(a.slice, obj)
+}
+
+// InsertSortedOrdered works like InsertSorted, but compares elements with their natural order.
+// It is only available for lists of ordered elements.
+func InsertSortedOrdered[T cmp.Ordered](a *ArrayListOf[T], obj T) int {
+	return a.InsertSorted(obj, cmp.Compare[T])
+}
+
+// IsSortedOrdered works like IsSorted, but compares elements with their natural order.
+// It is only available for lists of ordered elements.
+func IsSortedOrdered[T cmp.Ordered](a *ArrayListOf[T]) bool {
+	return slices.IsSorted(a.slice)
+}
+
+// SortOrdered works like Sort, but sorts elements in their natural increasing order.
+// It is only available for lists of ordered elements.
+func SortOrdered[T cmp.Ordered](a *ArrayListOf[T]) {
+	slices.Sort(a.slice)
+}
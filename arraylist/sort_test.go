@@ -0,0 +1,148 @@
+package arraylist
+
+import (
+	"cmp"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func intLess(a, b interface{}) bool {
+	return a.(int) < b.(int)
+}
+
+func intCmp(a, b interface{}) int {
+	return cmp.Compare(a.(int), b.(int))
+}
+
+func TestSort(t *testing.T) {
+	list := New()
+	list.Add(5, 3, 1, 4, 2)
+
+	if list.IsSorted(intLess) {
+		t.Errorf("%v should not be sorted", list.Slice())
+	}
+
+	list.Sort(intLess)
+	if expect := []interface{}{1, 2, 3, 4, 5}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if !list.IsSorted(intLess) {
+		t.Errorf("%v should be sorted", list.Slice())
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	list := New()
+	list.Add("bb", "a", "cc", "b", "aa", "c")
+
+	list.SortStable(func(a, b interface{}) bool {
+		return len(a.(string)) < len(b.(string))
+	})
+
+	if expect := []interface{}{"a", "b", "c", "bb", "cc", "aa"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+}
+
+func TestSort_ConcurrentModification(t *testing.T) {
+	list := New()
+	list.Add(2, 1)
+
+	it := list.ListIterator()
+	list.Sort(intLess)
+	if _, err := it.Next(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	list := New()
+	list.Add(1, 3, 5, 7)
+
+	if pos, found := list.BinarySearch(5, intCmp); pos != 2 || !found {
+		t.Errorf("5 should be found at 2, but was %d, %v", pos, found)
+	}
+
+	if pos, found := list.BinarySearch(4, intCmp); pos != 2 || found {
+		t.Errorf("4 should not be found and be inserted at 2, but was %d, %v", pos, found)
+	}
+
+	if pos, found := New().BinarySearch(4, intCmp); pos != 0 || found {
+		t.Errorf("4 should not be found and be inserted at 0, but was %d, %v", pos, found)
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	list := New()
+	for _, n := range []int{5, 1, 3, 5, 0, 9} {
+		list.InsertSorted(n, intCmp)
+	}
+
+	if expect := []interface{}{0, 1, 3, 5, 5, 9}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if pos := list.InsertSorted(5, intCmp); pos != 5 {
+		t.Errorf("5 should be inserted after equal elements at 5, but was %d", pos)
+	}
+
+	it := list.ListIterator()
+	list.InsertSorted(2, intCmp)
+	if _, err := it.Next(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+}
+
+func TestArrayListOf_Sort(t *testing.T) {
+	list := NewOf[string]()
+	list.Add("Banana", "apple", "cherry")
+
+	lessFold := func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+	list.Sort(lessFold)
+	if expect := []string{"apple", "Banana", "cherry"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if !list.IsSorted(lessFold) {
+		t.Errorf("%v should be sorted", list.Slice())
+	}
+
+	cmpFold := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+	if pos, found := list.BinarySearch("BANANA", cmpFold); pos != 1 || !found {
+		t.Errorf("BANANA should be found at 1, but was %d, %v", pos, found)
+	}
+
+	list.InsertSorted("Blueberry", cmpFold)
+	list.SortStable(func(a, b string) bool { return len(a) < len(b) })
+	if expect := []string{"apple", "Banana", "cherry", "Blueberry"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+}
+
+func TestSortOrdered(t *testing.T) {
+	list := NewOf[float64]()
+	list.Add(2.5, -1, 10, 0)
+
+	if IsSortedOrdered(list) {
+		t.Errorf("%v should not be sorted", list.Slice())
+	}
+
+	SortOrdered(list)
+	if expect := []float64{-1, 0, 2.5, 10}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if pos, found := BinarySearchOrdered(list, 2.5); pos != 2 || !found {
+		t.Errorf("2.5 should be found at 2, but was %d, %v", pos, found)
+	}
+
+	if pos := InsertSortedOrdered(list, 1); pos != 2 {
+		t.Errorf("1 should be inserted at 2, but was %d", pos)
+	}
+
+	if !IsSortedOrdered(list) {
+		t.Errorf("%v should be sorted", list.Slice())
+	}
+}
@@ -0,0 +1,102 @@
+package arraylist
+
+// SubList is a live view of a range of an ArrayList. Get and Set read and write the elements of
+// the backing list, and Clear removes the whole range from it.
+// The view is invalidated when the backing list is structurally modified by anything other than
+// the view itself; after that, its methods return ConcurrentModificationErr.
+type SubList struct {
+	parent           *ArrayList
+	offset           int
+	size             int
+	expectedModCount int
+}
+
+// SubList returns a view of the elements of this list between from, inclusive, and to, exclusive.
+// If from or to are out of the list bounds, or from is greater than to, then index out of range error is returned.
+func (a *ArrayList) SubList(from, to int) (*SubList, error) {
+	if err := a.checkBounds(from, to); err != nil {
+		return nil, err
+	}
+
+	return &SubList{
+		parent:           a,
+		offset:           from,
+		size:             to - from,
+		expectedModCount: a.modCount,
+	}, nil
+}
+
+// Clear removes all of the elements of this view from the backing list.
+// It can return ConcurrentModificationErr.
+func (s *SubList) Clear() error {
+	if err := s.checkModification(); err != nil {
+		return err
+	}
+
+	s.parent.RemoveRange(s.offset, s.offset+s.size)
+	s.size = 0
+	s.expectedModCount = s.parent.modCount
+	return nil
+}
+
+// Get returns the element at the specified position in this view.
+// It can return ConcurrentModificationErr or index out of range error.
+func (s *SubList) Get(pos int) (interface{}, error) {
+	if err := s.checkModification(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRange(pos); err != nil {
+		return nil, err
+	}
+
+	return s.parent.slice[s.offset+pos], nil
+}
+
+// Set replaces the element at the specified position in this view, and in the backing list, with
+// the specified element. It returns the element previously at the specified position.
+// It can return ConcurrentModificationErr or index out of range error.
+func (s *SubList) Set(pos int, obj interface{}) (interface{}, error) {
+	if err := s.checkModification(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRange(pos); err != nil {
+		return nil, err
+	}
+
+	return s.parent.Set(s.offset+pos, obj)
+}
+
+// Size returns the number of elements in this view.
+// Once the view is invalidated, it keeps returning the size it had before.
+func (s *SubList) Size() int {
+	return s.size
+}
+
+// Slice returns a slice containing all of the elements in this view.
+// To avoid references, the returned slice is a copy.
+// It can return ConcurrentModificationErr.
+func (s *SubList) Slice() ([]interface{}, error) {
+	if err := s.checkModification(); err != nil {
+		return nil, err
+	}
+
+	return append([]interface{}{}, s.parent.slice[s.offset:s.offset+s.size]...), nil
+}
+
+func (s *SubList) checkModification() error {
+	if s.parent.modCount != s.expectedModCount {
+		return ConcurrentModificationErr
+	}
+
+	return nil
+}
+
+func (s *SubList) checkRange(pos int) error {
+	if pos > s.size-1 || pos < 0 {
+		return indexOutOfRangeErr(pos, s.size)
+	}
+
+	return nil
+}
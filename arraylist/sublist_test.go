@@ -0,0 +1,121 @@
+package arraylist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubList(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c", "d", "e")
+
+	sub, err := list.SubList(1, 4)
+	if err != nil {
+		t.Fatalf("Error should be nil, but was %s", err.Error())
+	}
+
+	if size := sub.Size(); size != 3 {
+		t.Errorf("SubList should have a size of 3, but has %d", size)
+	}
+
+	if el, _ := sub.Get(0); el != "b" {
+		t.Errorf("Element should be 'b', but was '%v'", el)
+	}
+
+	if _, err = sub.Get(3); err == nil {
+		t.Error("Error should be index out of range")
+	}
+
+	previous, err := sub.Set(2, "D")
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if previous != "d" {
+		t.Errorf("Previous element should be 'd', but was '%v'", previous)
+	}
+
+	if el, _ := list.Get(3); el != "D" {
+		t.Errorf("Element in the backing list should be 'D', but was '%v'", el)
+	}
+
+	slice, err := sub.Slice()
+	if expect := []interface{}{"b", "c", "D"}; err != nil || !reflect.DeepEqual(slice, expect) {
+		t.Errorf("%v is not equal to %v, error %v", slice, expect, err)
+	}
+
+	list.Set(1, "B")
+	if el, _ := sub.Get(0); el != "B" {
+		t.Errorf("Element should be 'B', but was '%v'", el)
+	}
+}
+
+func TestSubList_Clear(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c", "d", "e")
+
+	sub, _ := list.SubList(1, 3)
+	if err := sub.Clear(); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if size := sub.Size(); size != 0 {
+		t.Errorf("SubList should have a size of 0, but has %d", size)
+	}
+
+	if expect := []interface{}{"a", "d", "e"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	if slice, err := sub.Slice(); err != nil || len(slice) != 0 {
+		t.Errorf("SubList should be empty and valid, but was %v, error %v", slice, err)
+	}
+}
+
+func TestSubList_Invalidated(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	sub, _ := list.SubList(0, 2)
+	list.Add("d")
+
+	if _, err := sub.Get(0); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	if _, err := sub.Set(0, "z"); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	if _, err := sub.Slice(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	if err := sub.Clear(); err != ConcurrentModificationErr {
+		t.Errorf("Error should be %v, but was %v", ConcurrentModificationErr, err)
+	}
+
+	if size := list.Size(); size != 4 {
+		t.Errorf("ArrayList should have a size of 4, but has %d", size)
+	}
+}
+
+func TestSubList_OutOfRange(t *testing.T) {
+	list := New()
+	list.Add("a", "b", "c")
+
+	for _, r := range [][2]int{{-1, 1}, {0, 4}, {2, 1}} {
+		if _, err := list.SubList(r[0], r[1]); err == nil {
+			t.Errorf("Error should be index out of range for %v", r)
+		}
+	}
+
+	sub, err := list.SubList(3, 3)
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if size := sub.Size(); size != 0 {
+		t.Errorf("SubList should have a size of 0, but has %d", size)
+	}
+}
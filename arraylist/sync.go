@@ -0,0 +1,293 @@
+package arraylist
+
+import (
+	"sync"
+)
+
+// SyncArrayList is an ArrayList that is safe for concurrent use by multiple goroutines.
+// Every method is guarded by a sync.RWMutex, and compound operations such as AddIfAbsent
+// or Update are atomic.
+type SyncArrayList struct {
+	mu   sync.RWMutex
+	list ArrayList
+}
+
+// NewSync returns a new *SyncArrayList
+func NewSync() *SyncArrayList {
+	return new(SyncArrayList)
+}
+
+// Add appends the specified elements to the end of this list.
+func (s *SyncArrayList) Add(objs ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.Add(objs...)
+}
+
+// AddAt inserts the specified elements at the specified position in this list.
+// If pos is more than the list size or less than 0, then index out of range
+// error is returned. Nil otherwise.
+func (s *SyncArrayList) AddAt(pos int, objs ...interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.AddAt(pos, objs...)
+}
+
+// AddFirst inserts the specified elements to the beginning of this list.
+func (s *SyncArrayList) AddFirst(objs ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.AddFirst(objs...)
+}
+
+// AddIfAbsent appends the specified element to the end of this list, only if this list does not
+// contain it already. It returns true if the element was added.
+func (s *SyncArrayList) AddIfAbsent(obj interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.list.IndexOf(obj) > -1 {
+		return false
+	}
+
+	s.list.Add(obj)
+	return true
+}
+
+// BinarySearch searches for the specified element in this list, which must be sorted in
+// increasing order according to cmpFunc.
+// It returns the position where the element is found, or where it would be inserted to keep
+// this list sorted, and true if the element was found.
+func (s *SyncArrayList) BinarySearch(obj interface{}, cmpFunc func(a, b interface{}) int) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.BinarySearch(obj, cmpFunc)
+}
+
+// Clear removes all of the elements from this list.
+func (s *SyncArrayList) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.Clear()
+}
+
+// Contains returns true if this list contains the specified element.
+func (s *SyncArrayList) Contains(obj interface{}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Contains(obj)
+}
+
+// ContainsAll returns true if this list contains all of the specified elements.
+func (s *SyncArrayList) ContainsAll(objs ...interface{}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.ContainsAll(objs...)
+}
+
+// Get returns the element at the specified position in this list.
+// It returns the element at the specified position if exists, otherwise returns nil.
+// Can return index out of range error.
+func (s *SyncArrayList) Get(pos int) (interface{}, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Get(pos)
+}
+
+// GetOrAdd returns the first element of this list equal to the specified element and true.
+// If this list does not contain it, the element is appended to the end of this list and
+// returned along with false.
+func (s *SyncArrayList) GetOrAdd(obj interface{}) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.list.IndexOf(obj); i > -1 {
+		return s.list.slice[i], true
+	}
+
+	s.list.Add(obj)
+	return obj, false
+}
+
+// IndexOf returns the index (0-based) of the first occurrence of the specified element in this list.
+// It can return -1 if this list does not contain the specified element.
+func (s *SyncArrayList) IndexOf(obj interface{}) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.IndexOf(obj)
+}
+
+// InsertSorted inserts the specified element into this list, which must be sorted in increasing
+// order according to cmpFunc, keeping it sorted. It returns the position where the element was inserted.
+func (s *SyncArrayList) InsertSorted(obj interface{}, cmpFunc func(a, b interface{}) int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.InsertSorted(obj, cmpFunc)
+}
+
+// IsEmpty returns true if this list containes no elements.
+func (s *SyncArrayList) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.IsEmpty()
+}
+
+// IsSorted returns true if this list is sorted in increasing order according to less.
+func (s *SyncArrayList) IsSorted(less func(a, b interface{}) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.IsSorted(less)
+}
+
+// LastIndexOf returns the index (0-based) of the last occurrence of the specified element in this list.
+// It can return -1 if this list does not contain the specified element.
+func (s *SyncArrayList) LastIndexOf(obj interface{}) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.LastIndexOf(obj)
+}
+
+// Remove removes the first occurrence of the specified element from this list.
+// If element not found, it returns an element not found error.
+func (s *SyncArrayList) Remove(obj interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Remove(obj)
+}
+
+// RemoveAt removes the element at the specified position (0-based) in this list.
+// It can return index out of range error.
+func (s *SyncArrayList) RemoveAt(pos int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RemoveAt(pos)
+}
+
+// RemoveAll removes from this list all of its elements that are equal to any of the specified elements.
+// It returns the number of removed elements.
+func (s *SyncArrayList) RemoveAll(objs ...interface{}) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RemoveAll(objs...)
+}
+
+// RemoveIf removes all of the elements of this list that satisfy the given predicate.
+// The predicate runs while this list is locked, so it must not call methods of this list.
+// This list is left unchanged if the predicate panics. If predicate is nil, nothing is removed.
+// It returns the number of removed elements.
+func (s *SyncArrayList) RemoveIf(predicate func(obj interface{}) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RemoveIf(predicate)
+}
+
+// RemoveRange removes from this list all of the elements whose index is between from, inclusive, and to, exclusive.
+// If from or to are out of the list bounds, or from is greater than to, then index out of range error is returned.
+func (s *SyncArrayList) RemoveRange(from, to int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RemoveRange(from, to)
+}
+
+// ReplaceAll replaces each element of this list with the result of applying replaceFunc to it.
+// replaceFunc runs while this list is locked, so it must not call methods of this list.
+func (s *SyncArrayList) ReplaceAll(replaceFunc func(obj interface{}) interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.ReplaceAll(replaceFunc)
+}
+
+// RetainAll removes from this list all of its elements that are not equal to any of the specified elements.
+// It returns the number of removed elements.
+func (s *SyncArrayList) RetainAll(objs ...interface{}) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RetainAll(objs...)
+}
+
+// Set replaces the element at the specified position in this list with the specified element.
+// It returns the element previously at the specified position.
+// It can return index out of range error.
+func (s *SyncArrayList) Set(pos int, obj interface{}) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Set(pos, obj)
+}
+
+// Size returns the number of elements in this list.
+func (s *SyncArrayList) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Size()
+}
+
+// Slice returns a slice containing all of the elements in this list.
+// It is the same as Snapshot.
+func (s *SyncArrayList) Slice() []interface{} {
+	return s.Snapshot()
+}
+
+// Snapshot returns a copy of all of the elements in this list, taken while no other goroutine
+// is modifying it.
+func (s *SyncArrayList) Snapshot() []interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Slice()
+}
+
+// Sort sorts this list in place in increasing order according to less.
+// less runs while this list is locked, so it must not call methods of this list.
+func (s *SyncArrayList) Sort(less func(a, b interface{}) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.Sort(less)
+}
+
+// SortStable sorts this list in place in increasing order according to less, keeping the
+// original order of equal elements.
+func (s *SyncArrayList) SortStable(less func(a, b interface{}) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.SortStable(less)
+}
+
+// Update replaces the element at the specified position in this list with the value returned by
+// updateFunc, which receives the current element. No other goroutine can access this list while
+// updateFunc runs, so it must not call methods of this list.
+// It can return index out of range error.
+func (s *SyncArrayList) Update(pos int, updateFunc func(obj interface{}) interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.list.checkRange(pos); err != nil {
+		return err
+	}
+
+	s.list.slice[pos] = updateFunc(s.list.slice[pos])
+	return nil
+}
@@ -0,0 +1,191 @@
+package arraylist
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSyncArrayList(t *testing.T) {
+	list := NewSync()
+	list.Add("b", "c")
+	list.AddFirst("a")
+
+	if err := list.AddAt(3, "d"); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if obj, _ := list.Get(3); obj != "d" {
+		t.Errorf("SyncArrayList 3rd element should be 'd', but was '%v'", obj)
+	}
+
+	if i := list.IndexOf("c"); i != 2 {
+		t.Errorf("Index should be 2, but was %d", i)
+	}
+
+	if err := list.Remove("a"); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if err := list.RemoveAt(10); err == nil {
+		t.Error("Error should be index out of range")
+	}
+
+	if expect := []interface{}{"b", "c", "d"}; !reflect.DeepEqual(list.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", list.Slice(), expect)
+	}
+
+	list.Clear()
+	if !list.IsEmpty() {
+		t.Errorf("SyncArrayList should be empty, but has %d elements", list.Size())
+	}
+}
+
+func TestSyncArrayList_AddIfAbsent(t *testing.T) {
+	list := NewSync()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			list.AddIfAbsent(i % 10)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if size := list.Size(); size != 10 {
+		t.Errorf("SyncArrayList should have a size of 10, but has %d", size)
+	}
+
+	if list.AddIfAbsent(1) {
+		t.Error("AddIfAbsent should return false for an element already in the list")
+	}
+}
+
+func TestSyncArrayList_GetOrAdd(t *testing.T) {
+	list := NewSync()
+
+	obj, found := list.GetOrAdd([]int{1})
+	if found {
+		t.Error("GetOrAdd should return false for a new element")
+	}
+
+	if obj, found = list.GetOrAdd([]int{1}); !found || !reflect.DeepEqual(obj, []int{1}) {
+		t.Errorf("GetOrAdd should return [1] and true, but returned %v and %t", obj, found)
+	}
+
+	if size := list.Size(); size != 1 {
+		t.Errorf("SyncArrayList should have a size of 1, but has %d", size)
+	}
+}
+
+func TestSyncArrayList_Update(t *testing.T) {
+	list := NewSync()
+	list.Add(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list.Update(0, func(obj interface{}) interface{} {
+				return obj.(int) + 1
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if obj, _ := list.Get(0); obj != 100 {
+		t.Errorf("Element should be 100, but was %v", obj)
+	}
+
+	if err := list.Update(1, nil); err == nil {
+		t.Error("Error should be index out of range")
+	}
+}
+
+func TestSyncArrayList_BulkMutations(t *testing.T) {
+	list := NewSync()
+	list.Add(1, 2, 3, 4, 5)
+
+	if previous, _ := list.Set(0, 10); previous != 1 {
+		t.Errorf("Previous element should be 1, but was %v", previous)
+	}
+
+	list.ReplaceAll(func(obj interface{}) interface{} {
+		return obj.(int) + 1
+	})
+
+	if removed := list.RemoveIf(func(obj interface{}) bool { return obj.(int) > 5 }); removed != 2 {
+		t.Errorf("RemoveIf should remove 2 elements, but removed %d", removed)
+	}
+
+	if !list.ContainsAll(3, 4, 5) || list.Contains(10) {
+		t.Errorf("%v should contain 3, 4 and 5 but not 10", list.Snapshot())
+	}
+
+	list.RemoveAll(3)
+	list.RetainAll(4, 5)
+	if err := list.RemoveRange(0, 1); err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if expect := []interface{}{5}; !reflect.DeepEqual(list.Snapshot(), expect) {
+		t.Errorf("%v is not equal to %v", list.Snapshot(), expect)
+	}
+}
+
+func TestSyncArrayList_Sort(t *testing.T) {
+	list := NewSync()
+	list.Add(3, 1, 2)
+
+	list.Sort(intLess)
+	list.InsertSorted(0, intCmp)
+	list.SortStable(intLess)
+
+	if !list.IsSorted(intLess) {
+		t.Errorf("%v should be sorted", list.Snapshot())
+	}
+
+	if pos, found := list.BinarySearch(2, intCmp); pos != 2 || !found {
+		t.Errorf("2 should be found at 2, but was %d, %v", pos, found)
+	}
+}
+
+func TestSyncArrayList_Concurrent(t *testing.T) {
+	list := NewSync()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(3)
+		go func(i int) {
+			defer wg.Done()
+			list.Add(i)
+		}(i)
+
+		go func() {
+			defer wg.Done()
+			snapshot := list.Snapshot()
+			for _, obj := range snapshot {
+				if obj == nil {
+					t.Error("Snapshot should not contain nil elements")
+				}
+			}
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+			list.IndexOf(i)
+			list.Size()
+		}(i)
+	}
+
+	wg.Wait()
+
+	if size := list.Size(); size != 50 {
+		t.Errorf("SyncArrayList should have a size of 50, but has %d", size)
+	}
+}
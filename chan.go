@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BatchChan returns a channel that receives the elements of in grouped in batches of up to size elements.
+// A batch is sent when it reaches size elements or, if maxWait is greater than 0, when maxWait has passed
+// since its first element was received. The last batch is sent when in is closed.
+// The returned channel is closed when in is closed or ctx is done.
+// If size is less than 1, then InvalidSizeErr is returned.
+func BatchChan(ctx context.Context, in <-chan interface{}, size int, maxWait time.Duration) (<-chan []interface{}, error) {
+	if size < 1 {
+		return nil, InvalidSizeErr
+	}
+
+	out := make(chan []interface{})
+	go func() {
+		defer close(out)
+
+		var (
+			batch   []interface{}
+			timer   *time.Timer
+			timeout <-chan time.Time
+		)
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+
+			if len(batch) == 0 {
+				return true
+			}
+
+			ok := sendChan(ctx, out, batch)
+			batch = nil
+			return ok
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			case obj, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				if batch = append(batch, obj); len(batch) == size {
+					if !flush() {
+						return
+					}
+				} else if len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// FanIn merges the channels returned by FanOut into a single channel.
+// It is the same as MergeChan(ctx, chans...).
+func FanIn(ctx context.Context, chans ...<-chan interface{}) <-chan interface{} {
+	return MergeChan(ctx, chans...)
+}
+
+// FanOut distributes the elements of in between n channels, so each element is received from only
+// one of them. Each returned channel is closed when in is closed or ctx is done.
+// If n is less than 1, then InvalidSizeErr is returned.
+func FanOut(ctx context.Context, in <-chan interface{}, n int) ([]<-chan interface{}, error) {
+	if n < 1 {
+		return nil, InvalidSizeErr
+	}
+
+	chans := make([]<-chan interface{}, n)
+	for i := range chans {
+		out := make(chan interface{})
+		chans[i] = out
+
+		go func() {
+			defer close(out)
+			forwardChan(ctx, in, out, func(obj interface{}) (interface{}, bool) {
+				return obj, true
+			})
+		}()
+	}
+
+	return chans, nil
+}
+
+// MapChan returns a channel that receives the values returned by mapFunc for each element of in.
+// The returned channel is closed when in is closed or ctx is done.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func MapChan(ctx context.Context, in <-chan interface{}, mapFunc MapFunc) (<-chan interface{}, error) {
+	if mapFunc == nil {
+		return nil, NilMapFuncErr
+	}
+
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		forwardChan(ctx, in, out, func(obj interface{}) (interface{}, bool) {
+			return mapFunc(obj), true
+		})
+	}()
+
+	return out, nil
+}
+
+// MergeChan returns a channel that receives the elements of all chans, in no particular order.
+// The returned channel is closed when all chans are closed or ctx is done.
+func MergeChan(ctx context.Context, chans ...<-chan interface{}) <-chan interface{} {
+	out := make(chan interface{})
+
+	var wg sync.WaitGroup
+	for _, in := range chans {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forwardChan(ctx, in, out, func(obj interface{}) (interface{}, bool) {
+				return obj, true
+			})
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// SelectChan returns a channel that receives the elements of in for which selectFunc returns true.
+// The returned channel is closed when in is closed or ctx is done.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func SelectChan(ctx context.Context, in <-chan interface{}, selectFunc SelectFunc) (<-chan interface{}, error) {
+	if selectFunc == nil {
+		return nil, NilSelectFuncErr
+	}
+
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		forwardChan(ctx, in, out, func(obj interface{}) (interface{}, bool) {
+			return obj, selectFunc(obj)
+		})
+	}()
+
+	return out, nil
+}
+
+// forwardChan sends to out the values returned by fn for each element of in, skipping the ones
+// for which fn returns false. It returns when in is closed or ctx is done.
+func forwardChan(ctx context.Context, in <-chan interface{}, out chan<- interface{}, fn func(obj interface{}) (interface{}, bool)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case obj, ok := <-in:
+			if !ok {
+				return
+			}
+
+			if value, send := fn(obj); send && !sendChan(ctx, out, value) {
+				return
+			}
+		}
+	}
+}
+
+// sendChan sends obj to out. It returns false if ctx is done before obj could be sent.
+func sendChan[T any](ctx context.Context, out chan<- T, obj T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case out <- obj:
+		return true
+	}
+}
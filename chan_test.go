@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func sourceChan(objs ...interface{}) <-chan interface{} {
+	in := make(chan interface{})
+	go func() {
+		defer close(in)
+		for _, obj := range objs {
+			in <- obj
+		}
+	}()
+
+	return in
+}
+
+func collectChan(in <-chan interface{}) []interface{} {
+	collection := make([]interface{}, 0)
+	for obj := range in {
+		collection = append(collection, obj)
+	}
+
+	return collection
+}
+
+func TestMapSelectChan(t *testing.T) {
+	ctx := context.Background()
+
+	even, err := SelectChan(ctx, sourceChan(1, 2, 3, 4), isEven)
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	doubled, err := MapChan(ctx, even, func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	})
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if result, expect := collectChan(doubled), []interface{}{4, 8}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("%v is not equal to %v", result, expect)
+	}
+
+	if _, err = MapChan(ctx, nil, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+
+	if _, err = SelectChan(ctx, nil, nil); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+}
+
+func TestMapChan_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	in := make(chan interface{})
+	out, _ := MapChan(ctx, in, identity)
+
+	cancel()
+	if _, ok := <-out; ok {
+		t.Error("Output channel should be closed after the context is done")
+	}
+}
+
+func TestBatchChan(t *testing.T) {
+	batches, err := BatchChan(context.Background(), sourceChan(1, 2, 3, 4, 5), 2, 0)
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	result := make([][]interface{}, 0)
+	for batch := range batches {
+		result = append(result, batch)
+	}
+
+	if expect := [][]interface{}{{1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("%v is not equal to %v", result, expect)
+	}
+
+	in := make(chan interface{})
+	batches, _ = BatchChan(context.Background(), in, 10, 10*time.Millisecond)
+
+	in <- 1
+	select {
+	case batch := <-batches:
+		if expect := []interface{}{1}; !reflect.DeepEqual(batch, expect) {
+			t.Errorf("%v is not equal to %v", batch, expect)
+		}
+	case <-time.After(time.Second):
+		t.Error("Batch should be sent after maxWait")
+	}
+
+	close(in)
+	if _, ok := <-batches; ok {
+		t.Error("Batches channel should be closed after the input is closed")
+	}
+
+	if _, err = BatchChan(context.Background(), in, 0, 0); err != InvalidSizeErr {
+		t.Errorf("Error should be %v, but was %v", InvalidSizeErr, err)
+	}
+}
+
+func TestFanOutFanIn(t *testing.T) {
+	ctx := context.Background()
+
+	objs := make([]interface{}, 100)
+	for i := range objs {
+		objs[i] = i
+	}
+
+	chans, err := FanOut(ctx, sourceChan(objs...), 4)
+	if size := len(chans); size != 4 {
+		t.Errorf("FanOut should return 4 channels, but returned %d", size)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	workers := make([]<-chan interface{}, len(chans))
+	for i, in := range chans {
+		workers[i], _ = MapChan(ctx, in, func(obj interface{}) interface{} {
+			return obj.(int) * 2
+		})
+	}
+
+	result := collectChan(FanIn(ctx, workers...))
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].(int) < result[j].(int)
+	})
+
+	for i, obj := range result {
+		if obj != i*2 {
+			t.Errorf("Element %d should be %d, but was %v", i, i*2, obj)
+		}
+	}
+
+	if size := len(result); size != 100 {
+		t.Errorf("Result len should be 100, but was %d", size)
+	}
+
+	if _, err = FanOut(ctx, nil, 0); err != InvalidSizeErr {
+		t.Errorf("Error should be %v, but was %v", InvalidSizeErr, err)
+	}
+}
+
+func TestMergeChan(t *testing.T) {
+	merged := collectChan(MergeChan(context.Background(), sourceChan(1, 2), sourceChan(3), sourceChan()))
+	if size := len(merged); size != 3 {
+		t.Errorf("Merged len should be 3, but was %d", size)
+	}
+
+	if merged = collectChan(MergeChan(context.Background())); len(merged) != 0 {
+		t.Errorf("Merged len should be 0, but was %d", len(merged))
+	}
+}
@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// PairFunc is the function to be called by ChunkWhile and SliceWhen.
+// It receives each pair of adjacent elements of the collection.
+type PairFunc func(prev interface{}, next interface{}) bool
+
+// Chunk returns a slice of consecutive groups of size elements of the collection.
+// The last group contains the remaining elements, so it can be smaller.
+// It is based on Ruby's `each_slice` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If size is less than 1, then InvalidSizeErr is returned.
+func Chunk(collection interface{}, size int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	if size < 1 {
+		return make([]interface{}, 0), InvalidSizeErr
+	}
+
+	chunks := make([]interface{}, 0, (collectionValue.Len()+size-1)/size)
+	for i := 0; i < collectionValue.Len(); i += size {
+		chunks = append(chunks, interfaceSlice(collectionValue, i, min(i+size, collectionValue.Len())))
+	}
+
+	return chunks, nil
+}
+
+// ChunkWhile splits the collection between each pair of adjacent elements for which pairFunc returns false.
+// It returns a slice with the resulting groups.
+// It is based on Ruby's `chunk_while` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If pairFunc is nil, then NilPairFuncErr is returned.
+func ChunkWhile(collection interface{}, pairFunc PairFunc) ([]interface{}, error) {
+	if pairFunc == nil {
+		return sliceWhen(collection, nil)
+	}
+
+	return sliceWhen(collection, func(prev interface{}, next interface{}) bool {
+		return !pairFunc(prev, next)
+	})
+}
+
+// EachCons returns a slice of windows of size consecutive elements of the collection.
+// The first window starts at the first element and each following window starts step elements later.
+// Only complete windows are returned.
+// It is based on Ruby's `each_cons` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If size or step are less than 1, then InvalidSizeErr is returned.
+func EachCons(collection interface{}, size, step int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	if size < 1 || step < 1 {
+		return make([]interface{}, 0), InvalidSizeErr
+	}
+
+	windows := make([]interface{}, 0)
+	for i := 0; i+size <= collectionValue.Len(); i += step {
+		windows = append(windows, interfaceSlice(collectionValue, i, i+size))
+	}
+
+	return windows, nil
+}
+
+// SliceWhen splits the collection between each pair of adjacent elements for which pairFunc returns true.
+// It returns a slice with the resulting groups.
+// It is based on Ruby's `slice_when` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If pairFunc is nil, then NilPairFuncErr is returned.
+func SliceWhen(collection interface{}, pairFunc PairFunc) ([]interface{}, error) {
+	return sliceWhen(collection, pairFunc)
+}
+
+// ChunkOf is the typed version of Chunk.
+// If size is less than 1, then InvalidSizeErr is returned.
+func ChunkOf[T any](collection []T, size int) ([][]T, error) {
+	if size < 1 {
+		return make([][]T, 0), InvalidSizeErr
+	}
+
+	chunks := make([][]T, 0, (len(collection)+size-1)/size)
+	for i := 0; i < len(collection); i += size {
+		chunks = append(chunks, append([]T{}, collection[i:min(i+size, len(collection))]...))
+	}
+
+	return chunks, nil
+}
+
+// ChunkWhileOf is the typed version of ChunkWhile.
+// pairFunc must not be nil.
+func ChunkWhileOf[T any](collection []T, pairFunc func(prev T, next T) bool) [][]T {
+	return SliceWhenOf(collection, func(prev T, next T) bool {
+		return !pairFunc(prev, next)
+	})
+}
+
+// EachConsOf is the typed version of EachCons.
+// If size or step are less than 1, then InvalidSizeErr is returned.
+func EachConsOf[T any](collection []T, size, step int) ([][]T, error) {
+	if size < 1 || step < 1 {
+		return make([][]T, 0), InvalidSizeErr
+	}
+
+	windows := make([][]T, 0)
+	for i := 0; i+size <= len(collection); i += step {
+		windows = append(windows, append([]T{}, collection[i:i+size]...))
+	}
+
+	return windows, nil
+}
+
+// SliceWhenOf is the typed version of SliceWhen.
+// pairFunc must not be nil.
+func SliceWhenOf[T any](collection []T, pairFunc func(prev T, next T) bool) [][]T {
+	groups := make([][]T, 0)
+
+	start := 0
+	for i := 1; i <= len(collection); i++ {
+		if i == len(collection) || pairFunc(collection[i-1], collection[i]) {
+			groups = append(groups, append([]T{}, collection[start:i]...))
+			start = i
+		}
+	}
+
+	return groups
+}
+
+func sliceWhen(collection interface{}, pairFunc PairFunc) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	if pairFunc == nil {
+		return make([]interface{}, 0), NilPairFuncErr
+	}
+
+	groups := make([]interface{}, 0)
+
+	start := 0
+	for i := 1; i <= collectionValue.Len(); i++ {
+		if i == collectionValue.Len() || pairFunc(collectionValue.Index(i-1).Interface(), collectionValue.Index(i).Interface()) {
+			groups = append(groups, interfaceSlice(collectionValue, start, i))
+			start = i
+		}
+	}
+
+	return groups, nil
+}
+
+// interfaceSlice returns a new []interface{} with the elements of sliceValue from i to j (exclusive).
+func interfaceSlice(sliceValue reflect.Value, i, j int) []interface{} {
+	slice := make([]interface{}, 0, j-i)
+	for ; i < j; i++ {
+		slice = append(slice, sliceValue.Index(i).Interface())
+	}
+
+	return slice
+}
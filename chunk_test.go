@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	c, err := Chunk([]int{1, 2, 3, 4, 5}, 2)
+	expect := []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+		[]interface{}{5},
+	}
+
+	if !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Chunk([]int{1}, 0); err != InvalidSizeErr {
+		t.Errorf("Error should be %v, but was %v", InvalidSizeErr, err)
+	}
+
+	if _, err = Chunk("Not a Collection", 1); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestChunkWhile(t *testing.T) {
+	c, _ := ChunkWhile([]int{1, 2, 4, 9, 10, 11, 12, 15}, func(prev interface{}, next interface{}) bool {
+		return prev.(int)+1 == next.(int)
+	})
+
+	expect := []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{4},
+		[]interface{}{9, 10, 11, 12},
+		[]interface{}{15},
+	}
+
+	if !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if _, err := ChunkWhile([]int{1}, nil); err != NilPairFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilPairFuncErr, err)
+	}
+}
+
+func TestEachCons(t *testing.T) {
+	w, _ := EachCons([]int{1, 2, 3, 4, 5}, 3, 1)
+	expect := []interface{}{
+		[]interface{}{1, 2, 3},
+		[]interface{}{2, 3, 4},
+		[]interface{}{3, 4, 5},
+	}
+
+	if !reflect.DeepEqual(w, expect) {
+		t.Errorf("%v is not equal to %v", w, expect)
+	}
+
+	w, _ = EachCons([]int{1, 2, 3, 4, 5}, 2, 2)
+	expect = []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+	}
+
+	if !reflect.DeepEqual(w, expect) {
+		t.Errorf("%v is not equal to %v", w, expect)
+	}
+
+	if _, err := EachCons([]int{1}, 1, 0); err != InvalidSizeErr {
+		t.Errorf("Error should be %v, but was %v", InvalidSizeErr, err)
+	}
+}
+
+func TestSliceWhen(t *testing.T) {
+	s, _ := SliceWhen([]int{1, 2, 6, 7, 8, 1}, func(prev interface{}, next interface{}) bool {
+		return prev.(int) > next.(int)
+	})
+
+	expect := []interface{}{
+		[]interface{}{1, 2, 6, 7, 8},
+		[]interface{}{1},
+	}
+
+	if !reflect.DeepEqual(s, expect) {
+		t.Errorf("%v is not equal to %v", s, expect)
+	}
+
+	if s, _ = SliceWhen([]int{}, func(prev interface{}, next interface{}) bool { return true }); len(s) != 0 {
+		t.Errorf("Slice len should be 0, but was %d", len(s))
+	}
+}
+
+func TestChunkOf(t *testing.T) {
+	c, _ := ChunkOf([]string{"a", "b", "c"}, 2)
+	expect := [][]string{{"a", "b"}, {"c"}}
+
+	if !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	w, _ := EachConsOf([]int{1, 2, 3}, 2, 1)
+	if expect := [][]int{{1, 2}, {2, 3}}; !reflect.DeepEqual(w, expect) {
+		t.Errorf("%v is not equal to %v", w, expect)
+	}
+
+	consecutive := ChunkWhileOf([]int{1, 2, 4, 5}, func(prev int, next int) bool {
+		return prev+1 == next
+	})
+
+	if expect := [][]int{{1, 2}, {4, 5}}; !reflect.DeepEqual(consecutive, expect) {
+		t.Errorf("%v is not equal to %v", consecutive, expect)
+	}
+
+	if _, err := ChunkOf([]int{1}, -1); err != InvalidSizeErr {
+		t.Errorf("Error should be %v, but was %v", InvalidSizeErr, err)
+	}
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+)
+
+// KeyValue is an entry of a map. Map, Select, Compact and IsIncluded receive the entries of a map
+// as KeyValue elements.
+type KeyValue struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// collectionSeq returns an iter.Seq over the elements of the collection:
+//   - slices and arrays yield their elements,
+//   - maps yield a KeyValue per entry, sorted by key,
+//   - strings yield their runes,
+//   - channels yield the received elements until they are closed.
+//
+// It also returns the number of elements the seq yields, to be used as a capacity hint, or 0 for channels.
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+func collectionSeq(collection interface{}) (iter.Seq[interface{}], int, error) {
+	collectionValue := reflect.ValueOf(collection)
+
+	switch collectionValue.Kind() {
+	case reflect.Invalid:
+		return nil, 0, NilCollectionErr
+	case reflect.Slice, reflect.Array:
+		return func(yield func(interface{}) bool) {
+			for i := 0; i < collectionValue.Len(); i++ {
+				if !yield(collectionValue.Index(i).Interface()) {
+					return
+				}
+			}
+		}, collectionValue.Len(), nil
+	case reflect.Map:
+		return func(yield func(interface{}) bool) {
+			for _, entry := range sortedEntries(collectionValue) {
+				if !yield(KeyValue{entry.key.Interface(), entry.value.Interface()}) {
+					return
+				}
+			}
+		}, collectionValue.Len(), nil
+	case reflect.String:
+		return func(yield func(interface{}) bool) {
+			for _, r := range collectionValue.String() {
+				if !yield(r) {
+					return
+				}
+			}
+		}, utf8.RuneCountInString(collectionValue.String()), nil
+	case reflect.Chan:
+		if collectionValue.IsNil() {
+			return nil, 0, NilCollectionErr
+		}
+
+		if collectionValue.Type().ChanDir()&reflect.RecvDir == 0 {
+			return nil, 0, NotSliceErr
+		}
+
+		return func(yield func(interface{}) bool) {
+			for {
+				obj, ok := collectionValue.Recv()
+				if !ok || !yield(obj.Interface()) {
+					return
+				}
+			}
+		}, 0, nil
+	}
+
+	return nil, 0, NotSliceErr
+}
+
+// collectionValues returns a new slice with the elements of the collection, for the functions that need
+// to access them by index. See collectionSeq for the collections accepted and the errors returned.
+func collectionValues(collection interface{}) ([]interface{}, error) {
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0, size)
+	for obj := range seq {
+		values = append(values, obj)
+	}
+
+	return values, nil
+}
+
+// mapEntry is a key and its value, read together from a map.
+type mapEntry struct {
+	key, value reflect.Value
+}
+
+// sortedEntries returns the entries of mapValue sorted by key. Keys of different types are sorted by type name,
+// and keys that can not be ordered by compareValues are sorted by their string representation.
+// Values are read along with their keys instead of being looked up with MapIndex, which can not
+// find keys that are not equal to themselves, such as NaN.
+func sortedEntries(mapValue reflect.Value) []mapEntry {
+	entries := make([]mapEntry, 0, mapValue.Len())
+	for iter := mapValue.MapRange(); iter.Next(); {
+		entries = append(entries, mapEntry{iter.Key(), iter.Value()})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return compareKeys(entries[i].key.Interface(), entries[j].key.Interface()) < 0
+	})
+
+	return entries
+}
+
+func compareKeys(a, b interface{}) int {
+	if aType, bType := fmt.Sprintf("%T", a), fmt.Sprintf("%T", b); aType != bType {
+		return cmp.Compare(aType, bType)
+	}
+
+	if result, err := compareValues(a, b); err == nil {
+		return result
+	}
+
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMap_Collections(t *testing.T) {
+	double := func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	}
+
+	newCollection, err := Map([3]int{1, 2, 3}, double)
+	if expect := []interface{}{2, 4, 6}; !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v", newCollection, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	newCollection, _ = Map(map[string]int{"c": 3, "a": 1, "b": 2}, func(obj interface{}) interface{} {
+		return obj.(KeyValue).Key
+	})
+
+	if expect := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v", newCollection, expect)
+	}
+
+	newCollection, err = Map(map[float64]int{math.NaN(): 1, 2.5: 2}, func(obj interface{}) interface{} {
+		return obj.(KeyValue).Value
+	})
+
+	if expect := []interface{}{1, 2}; err != nil || !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v, error %v", newCollection, expect, err)
+	}
+
+	newCollection, _ = Map("añb", func(obj interface{}) interface{} {
+		return string(obj.(rune))
+	})
+
+	if expect := []interface{}{"a", "ñ", "b"}; !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v", newCollection, expect)
+	}
+
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	close(in)
+
+	newCollection, _ = Map((<-chan int)(in), double)
+	if expect := []interface{}{2, 4}; !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v", newCollection, expect)
+	}
+
+	if _, err = Map(nil, double); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+
+	var nilChan chan int
+	if _, err = Map(nilChan, double); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+
+	if _, err = Map(make(chan<- int), double); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if _, err = Map(&TestStruct{}, double); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestSelect_Collections(t *testing.T) {
+	scores := map[string]int{"bob": 7, "alice": 9, "carol": 4}
+
+	passed, err := Select(scores, func(obj interface{}) bool {
+		return obj.(KeyValue).Value.(int) > 5
+	})
+
+	expect := []interface{}{KeyValue{"alice", 9}, KeyValue{"bob", 7}}
+	if !reflect.DeepEqual(passed, expect) {
+		t.Errorf("%v is not equal to %v", passed, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Select(nil, nil); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+}
+
+func TestCompact_Collections(t *testing.T) {
+	c, _ := Compact([3]interface{}{1, nil, 2})
+	if expect := []interface{}{1, 2}; !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if _, err := Compact(nil); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+}
+
+func TestIsIncluded_Collections(t *testing.T) {
+	if included, _ := IsIncluded("abc", 'b'); !included {
+		t.Error("b rune should be in collection, but it wasn't")
+	}
+
+	if included, _ := IsIncluded(map[int]string{1: "a"}, KeyValue{1, "a"}); !included {
+		t.Error("1 => a entry should be in collection, but it wasn't")
+	}
+
+	if _, err := IsIncluded(nil, 1); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	keys, _ := Map(map[interface{}]bool{2: true, "b": true, 1: true, "a": true, true: true}, func(obj interface{}) interface{} {
+		return obj.(KeyValue).Key
+	})
+
+	if expect := []interface{}{true, 1, 2, "a", "b"}; !reflect.DeepEqual(keys, expect) {
+		t.Errorf("%v is not equal to %v", keys, expect)
+	}
+}
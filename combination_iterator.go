@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"iter"
+	"math/bits"
+	"reflect"
+)
+
+// CombinationIterator yields the combinations returned by Combination one at a time,
+// without materializing all of them in memory.
+//
+//	it, _ := NewCombinationIterator([]int{1, 2}, []int{3, 4})
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+type CombinationIterator struct {
+	slices  []reflect.Value
+	indexes []int
+	value   []interface{}
+	started bool
+	done    bool
+}
+
+// NewCombinationIterator returns a *CombinationIterator over all combinations of elements from all slices.
+// If any of the slices is not a slice, then NotSliceErr is returned.
+func NewCombinationIterator(slices ...interface{}) (*CombinationIterator, error) {
+	values, err := sliceValues(slices)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CombinationIterator{
+		slices:  values,
+		indexes: make([]int, len(values)),
+		value:   make([]interface{}, len(values)),
+	}, nil
+}
+
+// Next advances the iterator to the next combination, which will then be available through Value.
+// It returns false when there are no more combinations.
+func (c *CombinationIterator) Next() bool {
+	if c.done {
+		return false
+	}
+
+	if !c.started {
+		c.started = true
+		if len(c.slices) == 0 || c.isEmpty() {
+			c.done = true
+			return false
+		}
+	} else if !c.advance() {
+		c.done = true
+		return false
+	}
+
+	for i, sliceValue := range c.slices {
+		c.value[i] = sliceValue.Index(c.indexes[i]).Interface()
+	}
+
+	return true
+}
+
+// Value returns the current combination.
+// The returned slice is a copy, so it's safe to keep it after calling Next again.
+func (c *CombinationIterator) Value() []interface{} {
+	if !c.started || c.done {
+		return nil
+	}
+
+	return append([]interface{}{}, c.value...)
+}
+
+// Stop ends the iteration. Following calls to Next return false.
+func (c *CombinationIterator) Stop() {
+	c.started = true
+	c.done = true
+}
+
+func (c *CombinationIterator) advance() bool {
+	for i := len(c.indexes) - 1; i > -1; i-- {
+		if c.indexes[i]++; c.indexes[i] < c.slices[i].Len() {
+			return true
+		}
+
+		c.indexes[i] = 0
+	}
+
+	return false
+}
+
+func (c *CombinationIterator) isEmpty() bool {
+	for _, sliceValue := range c.slices {
+		if sliceValue.Len() == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CombinationCount returns the number of combinations Combination would return for the specified slices,
+// without generating them.
+// If any of the slices is not a slice, then NotSliceErr is returned.
+// If the count does not fit in an int, then CountOverflowErr is returned.
+func CombinationCount(slices ...interface{}) (int, error) {
+	values, err := sliceValues(slices)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	count := uint64(1)
+	for _, sliceValue := range values {
+		hi, lo := bits.Mul64(count, uint64(sliceValue.Len()))
+		if hi != 0 || lo > uint64(maxInt) {
+			return 0, CountOverflowErr
+		}
+
+		count = lo
+	}
+
+	return int(count), nil
+}
+
+// CombinationSeq returns an iter.Seq that yields the combinations returned by Combination one at a time.
+// Breaking out of the range loop stops the generation.
+// If any of the slices is not a slice, then NotSliceErr is returned.
+func CombinationSeq(slices ...interface{}) (iter.Seq[[]interface{}], error) {
+	if _, err := sliceValues(slices); err != nil {
+		return nil, err
+	}
+
+	return func(yield func([]interface{}) bool) {
+		it, _ := NewCombinationIterator(slices...)
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}, nil
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+func sliceValues(slices []interface{}) ([]reflect.Value, error) {
+	values := make([]reflect.Value, len(slices))
+	for i, slice := range slices {
+		sliceValue := reflect.ValueOf(slice)
+		if sliceValue.Kind() != reflect.Slice {
+			return nil, NotSliceErr
+		}
+
+		values[i] = sliceValue
+	}
+
+	return values, nil
+}
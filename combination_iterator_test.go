@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCombinationIterator(t *testing.T) {
+	it, err := NewCombinationIterator([]int{1, 2, 3}, []string{"a", "b"})
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	combinations := make([]interface{}, 0)
+	for it.Next() {
+		combinations = append(combinations, it.Value())
+	}
+
+	expect, _ := Combination([]int{1, 2, 3}, []string{"a", "b"})
+	if !reflect.DeepEqual(combinations, expect) {
+		t.Errorf("%v is not equal to %v", combinations, expect)
+	}
+
+	if it.Next() {
+		t.Error("Next should return false after the last combination")
+	}
+
+	it, _ = NewCombinationIterator([]int{1, 2}, []int{})
+	if it.Next() {
+		t.Error("Next should return false for an empty slice")
+	}
+
+	it, _ = NewCombinationIterator([]int{1, 2}, []int{3, 4})
+	it.Next()
+	it.Stop()
+	if it.Next() {
+		t.Error("Next should return false after Stop")
+	}
+
+	if _, err = NewCombinationIterator([]int{1}, "Not a Collection"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestCombinationCount(t *testing.T) {
+	slice := make([]int, 50)
+
+	count, err := CombinationCount(slice, slice, slice, slice, slice)
+	if count != 312500000 {
+		t.Errorf("Count should be 312500000, but was %d", count)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if count, _ = CombinationCount([]int{1, 2}, []int{}); count != 0 {
+		t.Errorf("Count should be 0, but was %d", count)
+	}
+
+	big := make([]struct{}, 1<<20)
+	if _, err = CombinationCount(big, big, big, big); err != CountOverflowErr {
+		t.Errorf("Error should be %v, but was %v", CountOverflowErr, err)
+	}
+
+	if _, err = CombinationCount("Not a Collection"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestCombinationSeq(t *testing.T) {
+	slice := make([]int, 50)
+
+	seq, err := CombinationSeq(slice, slice, slice, slice, slice)
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	count := 0
+	for c := range seq {
+		if len(c) != 5 {
+			t.Errorf("Combination len should be 5, but was %d", len(c))
+		}
+
+		if count++; count == 10 {
+			break
+		}
+	}
+
+	if count != 10 {
+		t.Errorf("Count should be 10, but was %d", count)
+	}
+
+	if _, err = CombinationSeq("Not a Collection"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
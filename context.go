@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+)
+
+// CombinationContext works like Combination, but stops generating combinations when ctx is done.
+// In that case, the combinations generated so far are returned along with ctx.Err().
+// If any of the slices is not a slice, then NotSliceErr is returned.
+func CombinationContext(ctx context.Context, slices ...interface{}) ([]interface{}, error) {
+	it, err := NewCombinationIterator(slices...)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	combinations := make([]interface{}, 0)
+	for it.Next() {
+		if isDone(ctx) {
+			return combinations, ctx.Err()
+		}
+
+		combinations = append(combinations, it.Value())
+	}
+
+	return combinations, nil
+}
+
+// MapContext works like Map, but stops calling mapFunc when ctx is done.
+// In that case, the values returned by mapFunc so far are returned along with ctx.Err().
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// For channels, ctx is only checked between received elements.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func MapContext(ctx context.Context, collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if mapFunc == nil {
+		return make([]interface{}, 0), NilMapFuncErr
+	}
+
+	newColl := make([]interface{}, 0, size)
+	for obj := range seq {
+		if isDone(ctx) {
+			return newColl, ctx.Err()
+		}
+
+		newColl = append(newColl, mapFunc(obj))
+	}
+
+	return newColl, nil
+}
+
+// SelectContext works like Select, but stops calling selectFunc when ctx is done.
+// In that case, the elements selected so far are returned along with ctx.Err().
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// For channels, ctx is only checked between received elements.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func SelectContext(ctx context.Context, collection interface{}, selectFunc SelectFunc) ([]interface{}, error) {
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if selectFunc == nil {
+		return make([]interface{}, 0), NilSelectFuncErr
+	}
+
+	newColl := make([]interface{}, 0, size)
+	for obj := range seq {
+		if isDone(ctx) {
+			return newColl, ctx.Err()
+		}
+
+		if selectFunc(obj) {
+			newColl = append(newColl, obj)
+		}
+	}
+
+	return newColl, nil
+}
+
+// isDone returns true if ctx is done, without blocking.
+func isDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
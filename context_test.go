@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCombinationContext(t *testing.T) {
+	c, err := CombinationContext(context.Background(), []int{1, 2}, []int{3, 4})
+	if size := len(c); size != 4 {
+		t.Errorf("Slice len should be 4, but was %d", size)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	slice := make([]int, 50)
+	if c, err = CombinationContext(ctx, slice, slice, slice, slice, slice); err != context.Canceled {
+		t.Errorf("Error should be %v, but was %v", context.Canceled, err)
+	}
+
+	if size := len(c); size != 0 {
+		t.Errorf("Slice len should be 0, but was %d", size)
+	}
+
+	if _, err = CombinationContext(context.Background(), "Not a Collection"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestMapContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newCollection, err := MapContext(ctx, []int{1, 2, 3, 4}, func(obj interface{}) interface{} {
+		if obj == 2 {
+			cancel()
+		}
+
+		return obj.(int) * 10
+	})
+
+	if size := len(newCollection); size != 2 {
+		t.Errorf("New collection len should be 2, but was %d", size)
+	}
+
+	if err != context.Canceled {
+		t.Errorf("Error should be %v, but was %v", context.Canceled, err)
+	}
+
+	if _, err = MapContext(context.Background(), []int{}, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+}
+
+func TestSelectContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newCollection, err := SelectContext(ctx, []int{2, 4, 5, 6}, func(obj interface{}) bool {
+		if obj == 5 {
+			cancel()
+		}
+
+		return obj.(int)%2 == 0
+	})
+
+	if size := len(newCollection); size != 2 {
+		t.Errorf("New collection len should be 2, but was %d", size)
+	}
+
+	if err != context.Canceled {
+		t.Errorf("Error should be %v, but was %v", context.Canceled, err)
+	}
+
+	newCollection, err = SelectContext(context.Background(), []int{2, 4, 5, 6}, func(obj interface{}) bool {
+		return obj.(int)%2 == 0
+	})
+
+	if size := len(newCollection); size != 3 {
+		t.Errorf("New collection len should be 3, but was %d", size)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+}
+
+func TestMapSelectContext_Collections(t *testing.T) {
+	runes, err := MapContext(context.Background(), "ab", func(obj interface{}) interface{} {
+		return string(obj.(rune))
+	})
+
+	if expect := []interface{}{"a", "b"}; err != nil || !reflect.DeepEqual(runes, expect) {
+		t.Errorf("%v is not equal to %v, error %v", runes, expect, err)
+	}
+
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+
+	selected, err := SelectContext(context.Background(), (<-chan int)(in), func(obj interface{}) bool {
+		return obj.(int) != 2
+	})
+
+	if expect := []interface{}{1, 3}; err != nil || !reflect.DeepEqual(selected, expect) {
+		t.Errorf("%v is not equal to %v, error %v", selected, expect, err)
+	}
+
+	if _, err = MapContext(context.Background(), 42, nil); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
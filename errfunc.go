@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MapErrFunc is the function to be called by MapErr and MapErrAll.
+// It works like MapFunc, but can also return an error.
+type MapErrFunc func(obj interface{}) (interface{}, error)
+
+// SelectErrFunc is the function to be called by SelectErr and SelectErrAll.
+// It works like SelectFunc, but can also return an error.
+type SelectErrFunc func(obj interface{}) (bool, error)
+
+// ReduceErrFunc is the function to be called by TryReduce.
+// It works like ReduceFunc, but can also return an error.
+type ReduceErrFunc func(memo interface{}, obj interface{}) (interface{}, error)
+
+// IndexError wraps the error returned by a function for the element at Index.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError holds all the errors returned by MapErrAll and SelectErrAll.
+// Each of them is an *IndexError.
+type MultiError []error
+
+func (e MultiError) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e MultiError) Unwrap() []error {
+	return e
+}
+
+// MapErr works like Map, but stops at the first error returned by mapFunc.
+// That error is returned wrapped in an *IndexError.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func MapErr(collection interface{}, mapFunc MapErrFunc) ([]interface{}, error) {
+	return mapErr(collection, mapFunc, false)
+}
+
+// MapErrAll works like MapErr, but calls mapFunc for every element. The returned slice has nil at the
+// positions where mapFunc failed, and all the errors are returned in a MultiError.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func MapErrAll(collection interface{}, mapFunc MapErrFunc) ([]interface{}, error) {
+	return mapErr(collection, mapFunc, true)
+}
+
+// SelectErr works like Select, but stops at the first error returned by selectFunc.
+// That error is returned wrapped in an *IndexError.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func SelectErr(collection interface{}, selectFunc SelectErrFunc) ([]interface{}, error) {
+	return selectErr(collection, selectFunc, false)
+}
+
+// SelectErrAll works like SelectErr, but calls selectFunc for every element. The elements for which
+// selectFunc failed are not selected, and all the errors are returned in a MultiError.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func SelectErrAll(collection interface{}, selectFunc SelectErrFunc) ([]interface{}, error) {
+	return selectErr(collection, selectFunc, true)
+}
+
+// TryReduce works like Inject, but stops at the first error returned by reduceFunc.
+// That error is returned wrapped in an *IndexError, along with the value accumulated so far.
+// If collection is not a slice, then NotSliceErr is returned.
+// If reduceFunc is nil, then NilReduceFuncErr is returned.
+func TryReduce(collection interface{}, initial interface{}, reduceFunc ReduceErrFunc) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if reduceFunc == nil {
+		return nil, NilReduceFuncErr
+	}
+
+	memo := initial
+	for i := 0; i < collectionValue.Len(); i++ {
+		next, err := reduceFunc(memo, collectionValue.Index(i).Interface())
+		if err != nil {
+			return memo, &IndexError{i, err}
+		}
+
+		memo = next
+	}
+
+	return memo, nil
+}
+
+func mapErr(collection interface{}, mapFunc MapErrFunc, collectAll bool) ([]interface{}, error) {
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if mapFunc == nil {
+		return make([]interface{}, 0), NilMapFuncErr
+	}
+
+	var errs MultiError
+	newColl := make([]interface{}, 0, size)
+	i := -1
+	for item := range seq {
+		i++
+
+		obj, err := mapFunc(item)
+		if err != nil {
+			if !collectAll {
+				return make([]interface{}, 0), &IndexError{i, err}
+			}
+
+			errs = append(errs, &IndexError{i, err})
+			obj = nil
+		}
+
+		newColl = append(newColl, obj)
+	}
+
+	if len(errs) > 0 {
+		return newColl, errs
+	}
+
+	return newColl, nil
+}
+
+func selectErr(collection interface{}, selectFunc SelectErrFunc, collectAll bool) ([]interface{}, error) {
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if selectFunc == nil {
+		return make([]interface{}, 0), NilSelectFuncErr
+	}
+
+	var errs MultiError
+	newColl := make([]interface{}, 0, size)
+	i := -1
+	for obj := range seq {
+		i++
+
+		ok, err := selectFunc(obj)
+		if err != nil {
+			if !collectAll {
+				return make([]interface{}, 0), &IndexError{i, err}
+			}
+
+			errs = append(errs, &IndexError{i, err})
+			continue
+		}
+
+		if ok {
+			newColl = append(newColl, obj)
+		}
+	}
+
+	if len(errs) > 0 {
+		return newColl, errs
+	}
+
+	return newColl, nil
+}
@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	parse := func(obj interface{}) (interface{}, error) {
+		return strconv.Atoi(obj.(string))
+	}
+
+	newCollection, err := MapErr([]string{"1", "2", "3"}, parse)
+	if expect := []interface{}{1, 2, 3}; !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v", newCollection, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	calls := 0
+	_, err = MapErr([]string{"1", "x", "y"}, func(obj interface{}) (interface{}, error) {
+		calls++
+		return parse(obj)
+	})
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Error should be an *IndexError for element 1, but was %v", err)
+	}
+
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("Error should wrap a *strconv.NumError, but was %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("mapFunc should be called 2 times, but was called %d times", calls)
+	}
+
+	if _, err = MapErr(42, parse); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if _, err = MapErr([]string{}, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+}
+
+func TestMapErrAll(t *testing.T) {
+	newCollection, err := MapErrAll([]string{"1", "x", "3", "y"}, func(obj interface{}) (interface{}, error) {
+		return strconv.Atoi(obj.(string))
+	})
+
+	if expect := []interface{}{1, nil, 3, nil}; !reflect.DeepEqual(newCollection, expect) {
+		t.Errorf("%v is not equal to %v", newCollection, expect)
+	}
+
+	multiErr, ok := err.(MultiError)
+	if !ok || len(multiErr) != 2 {
+		t.Fatalf("Error should be a MultiError with 2 errors, but was %v", err)
+	}
+
+	if indexErr := multiErr[1].(*IndexError); indexErr.Index != 3 {
+		t.Errorf("Second error should be for element 3, but was for %d", indexErr.Index)
+	}
+}
+
+func TestSelectErr(t *testing.T) {
+	notNumberErr := errors.New("not a number")
+	isEven := func(obj interface{}) (bool, error) {
+		n, ok := obj.(int)
+		if !ok {
+			return false, notNumberErr
+		}
+
+		return n%2 == 0, nil
+	}
+
+	even, err := SelectErr([]interface{}{1, 2, 3, 4}, isEven)
+	if expect := []interface{}{2, 4}; !reflect.DeepEqual(even, expect) {
+		t.Errorf("%v is not equal to %v", even, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = SelectErr([]interface{}{1, "a", 2}, isEven); !errors.Is(err, notNumberErr) {
+		t.Errorf("Error should be %v, but was %v", notNumberErr, err)
+	}
+
+	even, err = SelectErrAll([]interface{}{1, "a", 2, "b"}, isEven)
+	if expect := []interface{}{2}; !reflect.DeepEqual(even, expect) {
+		t.Errorf("%v is not equal to %v", even, expect)
+	}
+
+	if multiErr, ok := err.(MultiError); !ok || len(multiErr) != 2 || !errors.Is(err, notNumberErr) {
+		t.Errorf("Error should be a MultiError with 2 errors, but was %v", err)
+	}
+
+	if _, err = SelectErr([]int{}, nil); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+}
+
+func TestMapErrSelectErr_Collections(t *testing.T) {
+	parse := func(obj interface{}) (interface{}, error) {
+		return strconv.Atoi(string(obj.(rune)))
+	}
+
+	digits, err := MapErr("123", parse)
+	if expect := []interface{}{1, 2, 3}; err != nil || !reflect.DeepEqual(digits, expect) {
+		t.Errorf("%v is not equal to %v, error %v", digits, expect, err)
+	}
+
+	digits, err = MapErrAll("1a3", parse)
+	if expect := []interface{}{1, nil, 3}; !reflect.DeepEqual(digits, expect) {
+		t.Errorf("%v is not equal to %v", digits, expect)
+	}
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Error should be an *IndexError at 1, but was %v", err)
+	}
+
+	selected, err := SelectErr([3]int{1, 2, 3}, func(obj interface{}) (bool, error) {
+		return obj.(int) > 1, nil
+	})
+
+	if expect := []interface{}{2, 3}; err != nil || !reflect.DeepEqual(selected, expect) {
+		t.Errorf("%v is not equal to %v, error %v", selected, expect, err)
+	}
+
+	if _, err = SelectErrAll(nil, nil); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+}
+
+func TestTryReduce(t *testing.T) {
+	sum := func(memo interface{}, obj interface{}) (interface{}, error) {
+		n, err := strconv.Atoi(obj.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return memo.(int) + n, nil
+	}
+
+	total, err := TryReduce([]string{"1", "2", "3"}, 0, sum)
+	if total != 6 {
+		t.Errorf("Total should be 6, but was %v", total)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	total, err = TryReduce([]string{"1", "2", "x", "4"}, 0, sum)
+	if total != 3 {
+		t.Errorf("Total should be 3, but was %v", total)
+	}
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 2 {
+		t.Errorf("Error should be an *IndexError for element 2, but was %v", err)
+	}
+
+	if _, err = TryReduce([]string{}, 0, nil); err != NilReduceFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilReduceFuncErr, err)
+	}
+}
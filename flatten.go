@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// FlatMap calls the specified mapFunc once for each element in the collection, and returns a new slice
+// with the values returned by the mapFunc flattened one level.
+// It is based on Ruby's `flat_map` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func FlatMap(collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
+	mapped, err := Map(collection, mapFunc)
+	if err != nil {
+		return mapped, err
+	}
+
+	return Flatten(mapped, 1)
+}
+
+// Flatten returns a new slice with the nested slices and arrays of the collection flattened recursively.
+// depth is the maximum number of levels to flatten; a negative depth flattens all of them.
+// It is based on Ruby's `flatten` method.
+// If collection is not a slice, then NotSliceErr is returned.
+func Flatten(collection interface{}, depth int) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	return flatten(make([]interface{}, 0, collectionValue.Len()), collectionValue, depth), nil
+}
+
+// FlatMapOf is the typed version of FlatMap.
+// mapFunc must not be nil.
+func FlatMapOf[T, U any](collection []T, mapFunc func(obj T) []U) []U {
+	flat := make([]U, 0, len(collection))
+	for _, item := range collection {
+		flat = append(flat, mapFunc(item)...)
+	}
+
+	return flat
+}
+
+// FlattenOf flattens one level of nested slices.
+func FlattenOf[T any](collection [][]T) []T {
+	return FlatMapOf(collection, func(obj []T) []T { return obj })
+}
+
+func flatten(flat []interface{}, sliceValue reflect.Value, depth int) []interface{} {
+	for i := 0; i < sliceValue.Len(); i++ {
+		item := sliceValue.Index(i)
+		if item.Kind() == reflect.Interface {
+			item = item.Elem()
+		}
+
+		if kind := item.Kind(); depth != 0 && (kind == reflect.Slice || kind == reflect.Array) {
+			flat = flatten(flat, item, depth-1)
+		} else {
+			flat = append(flat, sliceValue.Index(i).Interface())
+		}
+	}
+
+	return flat
+}
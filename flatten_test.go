@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	collection := []interface{}{
+		1,
+		[]int{2, 3},
+		[]interface{}{4, []interface{}{5, [2]int{6, 7}}},
+		"abc",
+		nil,
+	}
+
+	f, err := Flatten(collection, -1)
+	if expect := []interface{}{1, 2, 3, 4, 5, 6, 7, "abc", nil}; !reflect.DeepEqual(f, expect) {
+		t.Errorf("%v is not equal to %v", f, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	f, _ = Flatten(collection, 1)
+	expect := []interface{}{1, 2, 3, 4, []interface{}{5, [2]int{6, 7}}, "abc", nil}
+	if !reflect.DeepEqual(f, expect) {
+		t.Errorf("%v is not equal to %v", f, expect)
+	}
+
+	f, _ = Flatten(collection, 0)
+	if !reflect.DeepEqual(f, collection) {
+		t.Errorf("%v is not equal to %v", f, collection)
+	}
+
+	c, _ := Combination([]int{1, 2}, []int{3})
+	f, _ = Flatten(c, -1)
+	if expect := []interface{}{1, 3, 2, 3}; !reflect.DeepEqual(f, expect) {
+		t.Errorf("%v is not equal to %v", f, expect)
+	}
+
+	if _, err = Flatten("Not a Collection", 1); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	f, err := FlatMap([]int{1, 2}, func(obj interface{}) interface{} {
+		return []interface{}{obj, []int{obj.(int) * 10}}
+	})
+
+	if expect := []interface{}{1, []int{10}, 2, []int{20}}; !reflect.DeepEqual(f, expect) {
+		t.Errorf("%v is not equal to %v", f, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = FlatMap([]int{1}, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+}
+
+func TestFlatMapOf(t *testing.T) {
+	f := FlatMapOf([]string{"ab", "c"}, func(obj string) []rune {
+		return []rune(obj)
+	})
+
+	if expect := []rune{'a', 'b', 'c'}; !reflect.DeepEqual(f, expect) {
+		t.Errorf("%v is not equal to %v", f, expect)
+	}
+
+	if flat := FlattenOf([][]int{{1}, {2, 3}}); !reflect.DeepEqual(flat, []int{1, 2, 3}) {
+		t.Errorf("%v is not equal to %v", flat, []int{1, 2, 3})
+	}
+}
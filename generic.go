@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// CompactOf returns a copy of the specified collection with all nil elements removed.
+// Like Compact, only nil interfaces are removed, so typed nils such as nil pointers are kept;
+// see CompactDeepOf to remove them as well.
+func CompactOf[T any](collection []T) []T {
+	compact := make([]T, 0, len(collection))
+	for _, item := range collection {
+		if any(item) != nil {
+			compact = append(compact, item)
+		}
+	}
+
+	return compact
+}
+
+// CompactDeepOf is the typed version of CompactDeep. It returns a copy of the specified collection with
+// all nil elements removed, where an element is nil if it is a nil interface, pointer, map, slice, channel or function.
+func CompactDeepOf[T any](collection []T) []T {
+	compact := make([]T, 0, len(collection))
+	for _, item := range collection {
+		if !isNil(item) {
+			compact = append(compact, item)
+		}
+	}
+
+	return compact
+}
+
+// Includes returns true if the specified element is present in the specified collection, otherwise returns false.
+// Unlike IsIncluded, elements are compared with == and no error is returned.
+func Includes[T comparable](collection []T, obj T) bool {
+	for _, item := range collection {
+		if item == obj {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MapOf calls the specified mapFunc once for each element in the collection.
+// It returns a new slice containing the values returned by the mapFunc.
+// mapFunc must not be nil.
+func MapOf[T, U any](collection []T, mapFunc func(obj T) U) []U {
+	newColl := make([]U, len(collection))
+	for i, item := range collection {
+		newColl[i] = mapFunc(item)
+	}
+
+	return newColl
+}
+
+// SelectOf calls the specified selectFunc once for each element in the collection.
+// It returns a new slice containing all elements of the collection for which the specified selectFunc returns true.
+// selectFunc must not be nil.
+func SelectOf[T any](collection []T, selectFunc func(obj T) bool) []T {
+	newColl := make([]T, 0, len(collection))
+	for _, item := range collection {
+		if selectFunc(item) {
+			newColl = append(newColl, item)
+		}
+	}
+
+	return newColl
+}
+
+func isNil(obj interface{}) bool {
+	if obj == nil {
+		return true
+	}
+
+	switch value := reflect.ValueOf(obj); value.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		return value.IsNil()
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompactOf(t *testing.T) {
+	var nilStruct *TestStruct
+	collection := []*TestStruct{
+		&TestStruct{"Value A"},
+		nil,
+		&TestStruct{"Value B"},
+		nilStruct,
+	}
+
+	c := CompactOf(collection)
+	if size := len(c); size != 4 {
+		t.Errorf("Slice len should be 4, but was %d", size)
+	}
+
+	if expect, _ := Compact(collection); len(expect) != len(c) {
+		t.Errorf("CompactOf should keep %d elements like Compact, but kept %d", len(expect), len(c))
+	}
+
+	errs := CompactOf([]error{nil, errors.New("error"), nil})
+	if size := len(errs); size != 1 {
+		t.Errorf("Slice len should be 1, but was %d", size)
+	}
+
+	ints := CompactOf([]int{0, 1, 0})
+	if size := len(ints); size != 3 {
+		t.Errorf("Slice len should be 3, but was %d", size)
+	}
+}
+
+func TestCompactDeepOf(t *testing.T) {
+	var nilStruct *TestStruct
+	collection := []*TestStruct{
+		&TestStruct{"Value A"},
+		nil,
+		&TestStruct{"Value B"},
+		nilStruct,
+	}
+
+	c := CompactDeepOf(collection)
+	if size := len(c); size != 2 {
+		t.Errorf("Slice len should be 2, but was %d", size)
+	}
+
+	maps := CompactDeepOf([]map[string]int{nil, {"a": 1}, {}})
+	if size := len(maps); size != 2 {
+		t.Errorf("Slice len should be 2, but was %d", size)
+	}
+}
+
+func TestIncludes(t *testing.T) {
+	slice := []string{"a", "b", "c", "d"}
+
+	if !Includes(slice, "c") {
+		t.Error("C element should be in collection, but it wasn't")
+	}
+
+	if Includes(slice, "z") {
+		t.Error("Z element should not be in collection, but it was")
+	}
+}
+
+func TestMapOf(t *testing.T) {
+	collection := []*TestStruct{
+		&TestStruct{"Value A"},
+		&TestStruct{"Value B"},
+		&TestStruct{"Value C"},
+	}
+
+	values := MapOf(collection, func(obj *TestStruct) string {
+		return obj.Value
+	})
+
+	expect := []string{"Value A", "Value B", "Value C"}
+	if !reflect.DeepEqual(values, expect) {
+		t.Errorf("%v is not equal to %v", values, expect)
+	}
+
+	if size := len(MapOf([]int{}, func(obj int) int { return obj })); size != 0 {
+		t.Errorf("New collection len should be 0, but was %d", size)
+	}
+}
+
+func TestSelectOf(t *testing.T) {
+	collection := []int{1, 2, 3, 4, 5, 6}
+
+	even := SelectOf(collection, func(obj int) bool {
+		return obj%2 == 0
+	})
+
+	expect := []int{2, 4, 6}
+	if !reflect.DeepEqual(even, expect) {
+		t.Errorf("%v is not equal to %v", even, expect)
+	}
+}
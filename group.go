@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// CountBy calls the specified mapFunc once for each element in the collection, and returns a map
+// from each returned key to the number of elements for which it was returned.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If mapFunc returns a key that can not be used in a map, then NotHashableErr is returned.
+func CountBy(collection interface{}, mapFunc MapFunc) (map[interface{}]int, error) {
+	counts := make(map[interface{}]int)
+	err := eachKey(collection, mapFunc, func(key, obj interface{}) {
+		counts[key]++
+	})
+
+	return counts, err
+}
+
+// GroupBy calls the specified mapFunc once for each element in the collection, and returns a map
+// from each returned key to the elements for which it was returned, preserving their order.
+// It is based on Ruby's `group_by` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If mapFunc returns a key that can not be used in a map, then NotHashableErr is returned.
+func GroupBy(collection interface{}, mapFunc MapFunc) (map[interface{}][]interface{}, error) {
+	groups := make(map[interface{}][]interface{})
+	err := eachKey(collection, mapFunc, func(key, obj interface{}) {
+		groups[key] = append(groups[key], obj)
+	})
+
+	return groups, err
+}
+
+// IndexBy calls the specified mapFunc once for each element in the collection, and returns a map
+// from each returned key to the last element for which it was returned.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If mapFunc returns a key that can not be used in a map, then NotHashableErr is returned.
+func IndexBy(collection interface{}, mapFunc MapFunc) (map[interface{}]interface{}, error) {
+	index := make(map[interface{}]interface{})
+	err := eachKey(collection, mapFunc, func(key, obj interface{}) {
+		index[key] = obj
+	})
+
+	return index, err
+}
+
+// Partition calls the specified selectFunc once for each element in the collection.
+// It returns two new slices, the first one containing the elements for which selectFunc returns true,
+// and the second one containing the rest of them.
+// It is based on Ruby's `partition` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func Partition(collection interface{}, selectFunc SelectFunc) ([]interface{}, []interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), make([]interface{}, 0), NotSliceErr
+	}
+
+	if selectFunc == nil {
+		return make([]interface{}, 0), make([]interface{}, 0), NilSelectFuncErr
+	}
+
+	selected, rejected := make([]interface{}, 0), make([]interface{}, 0)
+	for i := 0; i < collectionValue.Len(); i++ {
+		if obj := collectionValue.Index(i).Interface(); selectFunc(obj) {
+			selected = append(selected, obj)
+		} else {
+			rejected = append(rejected, obj)
+		}
+	}
+
+	return selected, rejected, nil
+}
+
+func eachKey(collection interface{}, mapFunc MapFunc, keyFunc func(key, obj interface{})) error {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return NotSliceErr
+	}
+
+	if mapFunc == nil {
+		return NilMapFuncErr
+	}
+
+	for i := 0; i < collectionValue.Len(); i++ {
+		obj := collectionValue.Index(i).Interface()
+
+		key := mapFunc(obj)
+		if !isHashable(key) {
+			return NotHashableErr
+		}
+
+		keyFunc(key, obj)
+	}
+
+	return nil
+}
+
+// isHashable returns true if obj can be used as a map key without panicking.
+func isHashable(obj interface{}) bool {
+	return isHashableValue(reflect.ValueOf(obj))
+}
+
+func isHashableValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Interface:
+		return value.IsNil() || isHashableValue(value.Elem())
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if !isHashableValue(value.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if !isHashableValue(value.Field(i)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountBy(t *testing.T) {
+	counts, err := CountBy([]int{1, 2, 3, 4, 5}, func(obj interface{}) interface{} {
+		return obj.(int)%2 == 0
+	})
+
+	expect := map[interface{}]int{true: 2, false: 3}
+	if !reflect.DeepEqual(counts, expect) {
+		t.Errorf("%v is not equal to %v", counts, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	groups, err := GroupBy([]string{"one", "two", "three", "four"}, func(obj interface{}) interface{} {
+		return len(obj.(string))
+	})
+
+	expect := map[interface{}][]interface{}{
+		3: []interface{}{"one", "two"},
+		5: []interface{}{"three"},
+		4: []interface{}{"four"},
+	}
+
+	if !reflect.DeepEqual(groups, expect) {
+		t.Errorf("%v is not equal to %v", groups, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = GroupBy("Not a Collection", nil); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if _, err = GroupBy([]int{1}, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+
+	_, err = GroupBy([]int{1}, func(obj interface{}) interface{} {
+		return []int{obj.(int)}
+	})
+
+	if err != NotHashableErr {
+		t.Errorf("Error should be %v, but was %v", NotHashableErr, err)
+	}
+
+	_, err = GroupBy([]int{1}, func(obj interface{}) interface{} {
+		return struct{ Key interface{} }{[]int{obj.(int)}}
+	})
+
+	if err != NotHashableErr {
+		t.Errorf("Error should be %v, but was %v", NotHashableErr, err)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	collection := []*TestStruct{
+		&TestStruct{"Value A"},
+		&TestStruct{"Value B"},
+		&TestStruct{"Value A"},
+	}
+
+	index, err := IndexBy(collection, func(obj interface{}) interface{} {
+		return obj.(*TestStruct).Value
+	})
+
+	if size := len(index); size != 2 {
+		t.Errorf("Map len should be 2, but was %d", size)
+	}
+
+	if obj := index["Value A"]; obj != collection[2] {
+		t.Errorf("Element should be %v, but was %v", collection[2], obj)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even, odd, err := Partition([]int{1, 2, 3, 4, 5}, func(obj interface{}) bool {
+		return obj.(int)%2 == 0
+	})
+
+	if expect := []interface{}{2, 4}; !reflect.DeepEqual(even, expect) {
+		t.Errorf("%v is not equal to %v", even, expect)
+	}
+
+	if expect := []interface{}{1, 3, 5}; !reflect.DeepEqual(odd, expect) {
+		t.Errorf("%v is not equal to %v", odd, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, _, err = Partition([]int{1}, nil); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+}
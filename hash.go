@@ -0,0 +1,405 @@
+package utils
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+)
+
+// EntryFunc is the function to be called by FilterMap.
+// It receives each entry of the map and returns bool value. If true is returned,
+// the entry will be added to the returned map.
+type EntryFunc func(key interface{}, value interface{}) bool
+
+// ConflictFunc is the function to be called by Merge when both maps have a value for the same key.
+// It returns the value to keep.
+type ConflictFunc func(key interface{}, oldValue interface{}, newValue interface{}) interface{}
+
+// FilterMap returns a new map, of the same type as m, with the entries for which entryFunc returns true.
+// It is based on Ruby's `Hash#select` method.
+// If m is not a map, then NotMapErr is returned.
+// If entryFunc is nil, then NilEntryFuncErr is returned.
+func FilterMap(m interface{}, entryFunc EntryFunc) (interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return nil, NotMapErr
+	}
+
+	if entryFunc == nil {
+		return nil, NilEntryFuncErr
+	}
+
+	filtered := reflect.MakeMap(mapValue.Type())
+	for _, entry := range sortedEntries(mapValue) {
+		if entryFunc(entry.key.Interface(), entry.value.Interface()) {
+			filtered.SetMapIndex(entry.key, entry.value)
+		}
+	}
+
+	return filtered.Interface(), nil
+}
+
+// Invert returns a new map using the values of m as keys and the keys as values.
+// If several keys have the same value, the greatest key is kept.
+// It is based on Ruby's `Hash#invert` method.
+// If m is not a map, then NotMapErr is returned.
+// If any of the values can not be used as a key, then NotHashableErr is returned.
+func Invert(m interface{}) (interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return nil, NotMapErr
+	}
+
+	mapType := mapValue.Type()
+	if !mapType.Elem().Comparable() {
+		return nil, NotHashableErr
+	}
+
+	inverted := reflect.MakeMapWithSize(reflect.MapOf(mapType.Elem(), mapType.Key()), mapValue.Len())
+	for _, entry := range sortedEntries(mapValue) {
+		if !isHashable(entry.value.Interface()) {
+			return nil, NotHashableErr
+		}
+
+		inverted.SetMapIndex(entry.value, entry.key)
+	}
+
+	return inverted.Interface(), nil
+}
+
+// Keys returns a new slice with the keys of m, sorted.
+// It is based on Ruby's `Hash#keys` method.
+// If m is not a map, then NotMapErr is returned.
+func Keys(m interface{}) ([]interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return make([]interface{}, 0), NotMapErr
+	}
+
+	keys := make([]interface{}, 0, mapValue.Len())
+	for _, entry := range sortedEntries(mapValue) {
+		keys = append(keys, entry.key.Interface())
+	}
+
+	return keys, nil
+}
+
+// MapKeys returns a new map with the keys of m replaced by the values returned by mapFunc.
+// If mapFunc returns the same key for several entries, the value of the greatest original key is kept.
+// It is based on Ruby's `Hash#transform_keys` method.
+// If m is not a map, then NotMapErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If mapFunc returns a key that can not be used in a map, then NotHashableErr is returned.
+func MapKeys(m interface{}, mapFunc MapFunc) (map[interface{}]interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return make(map[interface{}]interface{}), NotMapErr
+	}
+
+	if mapFunc == nil {
+		return make(map[interface{}]interface{}), NilMapFuncErr
+	}
+
+	mapped := make(map[interface{}]interface{}, mapValue.Len())
+	for _, entry := range sortedEntries(mapValue) {
+		newKey := mapFunc(entry.key.Interface())
+		if !isHashable(newKey) {
+			return make(map[interface{}]interface{}), NotHashableErr
+		}
+
+		mapped[newKey] = entry.value.Interface()
+	}
+
+	return mapped, nil
+}
+
+// MapValues returns a new map with the values of m replaced by the values returned by mapFunc.
+// It is based on Ruby's `Hash#transform_values` method.
+// If m is not a map, then NotMapErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func MapValues(m interface{}, mapFunc MapFunc) (map[interface{}]interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return make(map[interface{}]interface{}), NotMapErr
+	}
+
+	if mapFunc == nil {
+		return make(map[interface{}]interface{}), NilMapFuncErr
+	}
+
+	mapped := make(map[interface{}]interface{}, mapValue.Len())
+	for _, entry := range sortedEntries(mapValue) {
+		mapped[entry.key.Interface()] = mapFunc(entry.value.Interface())
+	}
+
+	return mapped, nil
+}
+
+// Merge returns a new map, of the same type as m, with the entries of m and other.
+// Values that are maps in both m and other are merged recursively. For any other key present in both,
+// conflictFunc decides the value to keep; if conflictFunc is nil, the value of other is kept.
+// It is based on Ruby's `Hash#deep_merge` method.
+// If m or other are not maps, then NotMapErr is returned.
+// If other, or the value returned by conflictFunc, do not match the types of m, then MapTypeErr is returned.
+func Merge(m interface{}, other interface{}, conflictFunc ConflictFunc) (interface{}, error) {
+	mapValue, otherValue := reflect.ValueOf(m), reflect.ValueOf(other)
+	if mapValue.Kind() != reflect.Map || otherValue.Kind() != reflect.Map {
+		return nil, NotMapErr
+	}
+
+	merged, err := mergeMaps(mapValue, otherValue, conflictFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return merged.Interface(), nil
+}
+
+// Omit returns a new map, of the same type as m, without the specified keys.
+// It is based on Ruby's `Hash#except` method.
+// If m is not a map, then NotMapErr is returned.
+func Omit(m interface{}, keys ...interface{}) (interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return nil, NotMapErr
+	}
+
+	omitted := reflect.MakeMap(mapValue.Type())
+	for iter := mapValue.MapRange(); iter.Next(); {
+		if included, _ := IsIncluded(keys, iter.Key().Interface()); !included {
+			omitted.SetMapIndex(iter.Key(), iter.Value())
+		}
+	}
+
+	return omitted.Interface(), nil
+}
+
+// Pick returns a new map, of the same type as m, with only the specified keys. Keys not present in m are ignored.
+// It is based on Ruby's `Hash#slice` method.
+// If m is not a map, then NotMapErr is returned.
+// If any of the keys does not match the key type of m, then MapTypeErr is returned.
+func Pick(m interface{}, keys ...interface{}) (interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return nil, NotMapErr
+	}
+
+	picked := reflect.MakeMap(mapValue.Type())
+	for _, key := range keys {
+		keyValue, err := valueOfType(key, mapValue.Type().Key())
+		if err != nil {
+			return nil, err
+		}
+
+		if value := mapValue.MapIndex(keyValue); value.IsValid() {
+			picked.SetMapIndex(keyValue, value)
+		}
+	}
+
+	return picked.Interface(), nil
+}
+
+// Values returns a new slice with the values of m, sorted by their keys.
+// It is based on Ruby's `Hash#values` method.
+// If m is not a map, then NotMapErr is returned.
+func Values(m interface{}) ([]interface{}, error) {
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return make([]interface{}, 0), NotMapErr
+	}
+
+	values := make([]interface{}, 0, mapValue.Len())
+	for _, entry := range sortedEntries(mapValue) {
+		values = append(values, entry.value.Interface())
+	}
+
+	return values, nil
+}
+
+// FilterMapOf is the typed version of FilterMap.
+// entryFunc must not be nil.
+func FilterMapOf[K comparable, V any](m map[K]V, entryFunc func(key K, value V) bool) map[K]V {
+	filtered := make(map[K]V)
+	for key, value := range m {
+		if entryFunc(key, value) {
+			filtered[key] = value
+		}
+	}
+
+	return filtered
+}
+
+// InvertOf is the typed version of Invert.
+// If several keys have the same value, the greatest key is kept.
+func InvertOf[K cmp.Ordered, V comparable](m map[K]V) map[V]K {
+	inverted := make(map[V]K, len(m))
+	for _, key := range KeysOf(m) {
+		inverted[m[key]] = key
+	}
+
+	return inverted
+}
+
+// KeysOf is the typed version of Keys.
+func KeysOf[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// MapKeysOf is the typed version of MapKeys.
+// If mapFunc returns the same key for several entries, the value of the greatest original key is kept.
+// mapFunc must not be nil.
+func MapKeysOf[K cmp.Ordered, V any, L comparable](m map[K]V, mapFunc func(key K) L) map[L]V {
+	mapped := make(map[L]V, len(m))
+	for _, key := range KeysOf(m) {
+		mapped[mapFunc(key)] = m[key]
+	}
+
+	return mapped
+}
+
+// MapValuesOf is the typed version of MapValues.
+// mapFunc must not be nil.
+func MapValuesOf[K comparable, V, W any](m map[K]V, mapFunc func(value V) W) map[K]W {
+	mapped := make(map[K]W, len(m))
+	for key, value := range m {
+		mapped[key] = mapFunc(value)
+	}
+
+	return mapped
+}
+
+// MergeOf is a typed, not recursive, version of Merge.
+// If conflictFunc is nil, the value of other is kept.
+func MergeOf[K comparable, V any](m map[K]V, other map[K]V, conflictFunc func(key K, oldValue V, newValue V) V) map[K]V {
+	merged := make(map[K]V, len(m)+len(other))
+	for key, value := range m {
+		merged[key] = value
+	}
+
+	for key, value := range other {
+		if oldValue, ok := merged[key]; ok && conflictFunc != nil {
+			value = conflictFunc(key, oldValue, value)
+		}
+
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// OmitOf is the typed version of Omit.
+func OmitOf[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omitted := make(map[K]V, len(m))
+	for key, value := range m {
+		omitted[key] = value
+	}
+
+	for _, key := range keys {
+		delete(omitted, key)
+	}
+
+	return omitted
+}
+
+// PickOf is the typed version of Pick.
+func PickOf[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	picked := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if value, ok := m[key]; ok {
+			picked[key] = value
+		}
+	}
+
+	return picked
+}
+
+// ValuesOf is the typed version of Values.
+func ValuesOf[K cmp.Ordered, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, key := range KeysOf(m) {
+		values = append(values, m[key])
+	}
+
+	return values
+}
+
+func mergeMaps(mapValue, otherValue reflect.Value, conflictFunc ConflictFunc) (reflect.Value, error) {
+	mapType := mapValue.Type()
+	if otherValue.Type() != mapType {
+		return reflect.Value{}, MapTypeErr
+	}
+
+	merged := reflect.MakeMapWithSize(mapType, mapValue.Len()+otherValue.Len())
+	for iter := mapValue.MapRange(); iter.Next(); {
+		merged.SetMapIndex(iter.Key(), iter.Value())
+	}
+
+	for _, entry := range sortedEntries(otherValue) {
+		key, newValue := entry.key, entry.value
+
+		oldValue := merged.MapIndex(key)
+		if !oldValue.IsValid() {
+			merged.SetMapIndex(key, newValue)
+			continue
+		}
+
+		value, err := mergeValues(key, oldValue, newValue, mapType.Elem(), conflictFunc)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		merged.SetMapIndex(key, value)
+	}
+
+	return merged, nil
+}
+
+func mergeValues(key, oldValue, newValue reflect.Value, elemType reflect.Type, conflictFunc ConflictFunc) (reflect.Value, error) {
+	oldElem, newElem := oldValue, newValue
+	if oldElem.Kind() == reflect.Interface {
+		oldElem, newElem = oldElem.Elem(), newElem.Elem()
+	}
+
+	if oldElem.Kind() == reflect.Map && newElem.Kind() == reflect.Map && oldElem.Type() == newElem.Type() {
+		merged, err := mergeMaps(oldElem, newElem, conflictFunc)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return valueOfType(merged.Interface(), elemType)
+	}
+
+	if conflictFunc == nil {
+		return newValue, nil
+	}
+
+	return valueOfType(conflictFunc(key.Interface(), oldValue.Interface(), newValue.Interface()), elemType)
+}
+
+// valueOfType returns the reflect.Value of obj as a value of type t.
+// If obj can not be assigned to t, then MapTypeErr is returned.
+func valueOfType(obj interface{}, t reflect.Type) (reflect.Value, error) {
+	if obj == nil {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return reflect.Zero(t), nil
+		}
+
+		return reflect.Value{}, MapTypeErr
+	}
+
+	value := reflect.ValueOf(obj)
+	if !value.Type().AssignableTo(t) {
+		return reflect.Value{}, MapTypeErr
+	}
+
+	result := reflect.New(t).Elem()
+	result.Set(value)
+	return result, nil
+}
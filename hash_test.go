@@ -0,0 +1,278 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeysValues(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	keys, err := Keys(m)
+	if expect := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(keys, expect) {
+		t.Errorf("%v is not equal to %v", keys, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	values, _ := Values(m)
+	if expect := []interface{}{1, 2, 3}; !reflect.DeepEqual(values, expect) {
+		t.Errorf("%v is not equal to %v", values, expect)
+	}
+
+	if _, err = Keys([]int{1}); err != NotMapErr {
+		t.Errorf("Error should be %v, but was %v", NotMapErr, err)
+	}
+
+	if _, err = Values(nil); err != NotMapErr {
+		t.Errorf("Error should be %v, but was %v", NotMapErr, err)
+	}
+}
+
+func TestNaNKey(t *testing.T) {
+	m := map[float64]int{math.NaN(): 1, 2: 2}
+
+	values, err := Values(m)
+	if expect := []interface{}{1, 2}; err != nil || !reflect.DeepEqual(values, expect) {
+		t.Errorf("%v is not equal to %v, error %v", values, expect, err)
+	}
+
+	filtered, _ := FilterMap(m, func(key interface{}, value interface{}) bool {
+		return value.(int) == 1
+	})
+
+	if size := len(filtered.(map[float64]int)); size != 1 {
+		t.Errorf("Filtered map should have a size of 1, but has %d", size)
+	}
+
+	inverted, _ := Invert(m)
+	if key := inverted.(map[int]float64)[1]; !math.IsNaN(key) {
+		t.Errorf("Inverted key of 1 should be NaN, but was %v", key)
+	}
+
+	mappedKeys, _ := MapKeys(m, func(obj interface{}) interface{} {
+		return math.IsNaN(obj.(float64))
+	})
+
+	if expect := map[interface{}]interface{}{true: 1, false: 2}; !reflect.DeepEqual(mappedKeys, expect) {
+		t.Errorf("%v is not equal to %v", mappedKeys, expect)
+	}
+
+	mappedValues, _ := MapValues(m, func(obj interface{}) interface{} {
+		return obj.(int) * 10
+	})
+
+	if size := len(mappedValues); size != 2 {
+		t.Errorf("Mapped map should have a size of 2, but has %d", size)
+	}
+
+	omitted, _ := Omit(m, 2.0)
+	if size := len(omitted.(map[float64]int)); size != 1 {
+		t.Errorf("Omitted map should have a size of 1, but has %d", size)
+	}
+
+	merged, err := Merge(m, map[float64]int{math.NaN(): 3, 2: 4}, nil)
+	if size := len(merged.(map[float64]int)); err != nil || size != 3 {
+		t.Errorf("Merged map should have a size of 3, but has %d, error %v", size, err)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	inverted, err := Invert(map[string]int{"a": 1, "b": 2, "c": 1})
+	if expect := map[int]string{1: "c", 2: "b"}; !reflect.DeepEqual(inverted, expect) {
+		t.Errorf("%v is not equal to %v", inverted, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Invert(map[string][]int{"a": {1}}); err != NotHashableErr {
+		t.Errorf("Error should be %v, but was %v", NotHashableErr, err)
+	}
+
+	if _, err = Invert(map[string]interface{}{"a": []int{1}}); err != NotHashableErr {
+		t.Errorf("Error should be %v, but was %v", NotHashableErr, err)
+	}
+}
+
+func TestMapKeysMapValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	upper, err := MapKeys(m, func(obj interface{}) interface{} {
+		return strings.ToUpper(obj.(string))
+	})
+
+	if expect := map[interface{}]interface{}{"A": 1, "B": 2}; !reflect.DeepEqual(upper, expect) {
+		t.Errorf("%v is not equal to %v", upper, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	doubled, _ := MapValues(m, func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	})
+
+	if expect := map[interface{}]interface{}{"a": 2, "b": 4}; !reflect.DeepEqual(doubled, expect) {
+		t.Errorf("%v is not equal to %v", doubled, expect)
+	}
+
+	if _, err = MapValues(m, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+
+	if _, err = MapKeys(m, func(obj interface{}) interface{} { return []int{} }); err != NotHashableErr {
+		t.Errorf("Error should be %v, but was %v", NotHashableErr, err)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	filtered, err := FilterMap(map[string]int{"a": 1, "b": 2, "c": 3}, func(key interface{}, value interface{}) bool {
+		return value.(int) > 1
+	})
+
+	if expect := map[string]int{"b": 2, "c": 3}; !reflect.DeepEqual(filtered, expect) {
+		t.Errorf("%v is not equal to %v", filtered, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = FilterMap(map[string]int{}, nil); err != NilEntryFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilEntryFuncErr, err)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	m := map[string]interface{}{
+		"name": "app",
+		"db":   map[string]interface{}{"host": "localhost", "port": 5432},
+		"tags": 1,
+	}
+
+	other := map[string]interface{}{
+		"db":   map[string]interface{}{"port": 6543, "user": "admin"},
+		"tags": 2,
+	}
+
+	merged, err := Merge(m, other, nil)
+	expect := map[string]interface{}{
+		"name": "app",
+		"db":   map[string]interface{}{"host": "localhost", "port": 6543, "user": "admin"},
+		"tags": 2,
+	}
+
+	if !reflect.DeepEqual(merged, expect) {
+		t.Errorf("%v is not equal to %v", merged, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	merged, _ = Merge(m, other, func(key interface{}, oldValue interface{}, newValue interface{}) interface{} {
+		return oldValue.(int) + newValue.(int)
+	})
+
+	expect["db"].(map[string]interface{})["port"] = 5432 + 6543
+	expect["tags"] = 3
+	if !reflect.DeepEqual(merged, expect) {
+		t.Errorf("%v is not equal to %v", merged, expect)
+	}
+
+	if m["tags"] != 1 {
+		t.Error("Merge should not modify the original map")
+	}
+
+	_, err = Merge(map[string]int{"a": 1}, map[string]int{"a": 2}, func(key interface{}, oldValue interface{}, newValue interface{}) interface{} {
+		return "not an int"
+	})
+
+	if err != MapTypeErr {
+		t.Errorf("Error should be %v, but was %v", MapTypeErr, err)
+	}
+
+	if _, err = Merge(map[string]int{}, map[int]int{}, nil); err != MapTypeErr {
+		t.Errorf("Error should be %v, but was %v", MapTypeErr, err)
+	}
+
+	if _, err = Merge(map[string]int{}, nil, nil); err != NotMapErr {
+		t.Errorf("Error should be %v, but was %v", NotMapErr, err)
+	}
+}
+
+func TestPickOmit(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	picked, err := Pick(m, "a", "c", "z")
+	if expect := map[string]int{"a": 1, "c": 3}; !reflect.DeepEqual(picked, expect) {
+		t.Errorf("%v is not equal to %v", picked, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Pick(m, 1); err != MapTypeErr {
+		t.Errorf("Error should be %v, but was %v", MapTypeErr, err)
+	}
+
+	omitted, _ := Omit(m, "a", "c")
+	if expect := map[string]int{"b": 2}; !reflect.DeepEqual(omitted, expect) {
+		t.Errorf("%v is not equal to %v", omitted, expect)
+	}
+}
+
+func TestMapFunctionsOf(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 1}
+
+	if keys := KeysOf(m); !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("%v is not equal to %v", keys, []string{"a", "b", "c"})
+	}
+
+	if values := ValuesOf(m); !reflect.DeepEqual(values, []int{1, 2, 1}) {
+		t.Errorf("%v is not equal to %v", values, []int{1, 2, 1})
+	}
+
+	if inverted := InvertOf(m); !reflect.DeepEqual(inverted, map[int]string{1: "c", 2: "b"}) {
+		t.Errorf("%v is not equal to %v", inverted, map[int]string{1: "c", 2: "b"})
+	}
+
+	upper := MapKeysOf(m, strings.ToUpper)
+	if expect := map[string]int{"A": 1, "B": 2, "C": 1}; !reflect.DeepEqual(upper, expect) {
+		t.Errorf("%v is not equal to %v", upper, expect)
+	}
+
+	odd := MapValuesOf(m, func(value int) bool { return value%2 == 1 })
+	if expect := map[string]bool{"a": true, "b": false, "c": true}; !reflect.DeepEqual(odd, expect) {
+		t.Errorf("%v is not equal to %v", odd, expect)
+	}
+
+	filtered := FilterMapOf(m, func(key string, value int) bool { return value == 1 })
+	if expect := map[string]int{"a": 1, "c": 1}; !reflect.DeepEqual(filtered, expect) {
+		t.Errorf("%v is not equal to %v", filtered, expect)
+	}
+
+	merged := MergeOf(m, map[string]int{"a": 10, "d": 4}, func(key string, oldValue, newValue int) int {
+		return oldValue + newValue
+	})
+
+	if expect := map[string]int{"a": 11, "b": 2, "c": 1, "d": 4}; !reflect.DeepEqual(merged, expect) {
+		t.Errorf("%v is not equal to %v", merged, expect)
+	}
+
+	if picked := PickOf(m, "a", "z"); !reflect.DeepEqual(picked, map[string]int{"a": 1}) {
+		t.Errorf("%v is not equal to %v", picked, map[string]int{"a": 1})
+	}
+
+	if omitted := OmitOf(m, "a", "b"); !reflect.DeepEqual(omitted, map[string]int{"c": 1}) {
+		t.Errorf("%v is not equal to %v", omitted, map[string]int{"c": 1})
+	}
+}
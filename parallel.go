@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// PanicError is returned by ParallelMap and ParallelSelect when mapFunc or selectFunc panics.
+type PanicError struct {
+	// Index is the position of the element being processed when the panic happened.
+	Index int
+	// Value is the value passed to panic.
+	Value interface{}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic while processing element %d: %v", e.Index, e.Value)
+}
+
+// ParallelMap works like Map, but calls mapFunc from a pool of workers goroutines.
+// The returned slice keeps the order of the collection.
+// If workers is less than 1, runtime.GOMAXPROCS(0) workers are used.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If mapFunc panics, the remaining elements are skipped and a *PanicError is returned.
+// If ctx is done before all elements are processed, then ctx.Err() is returned.
+func ParallelMap(ctx context.Context, collection interface{}, workers int, mapFunc MapFunc) ([]interface{}, error) {
+	values, err := collectionValues(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if mapFunc == nil {
+		return make([]interface{}, 0), NilMapFuncErr
+	}
+
+	newColl := make([]interface{}, len(values))
+	err = parallelEach(ctx, len(values), workers, func(i int) {
+		newColl[i] = mapFunc(values[i])
+	})
+
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	return newColl, nil
+}
+
+// ParallelSelect works like Select, but calls selectFunc from a pool of workers goroutines.
+// The returned slice keeps the order of the collection.
+// If workers is less than 1, runtime.GOMAXPROCS(0) workers are used.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+// If selectFunc panics, the remaining elements are skipped and a *PanicError is returned.
+// If ctx is done before all elements are processed, then ctx.Err() is returned.
+func ParallelSelect(ctx context.Context, collection interface{}, workers int, selectFunc SelectFunc) ([]interface{}, error) {
+	values, err := collectionValues(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if selectFunc == nil {
+		return make([]interface{}, 0), NilSelectFuncErr
+	}
+
+	selected := make([]bool, len(values))
+	err = parallelEach(ctx, len(values), workers, func(i int) {
+		selected[i] = selectFunc(values[i])
+	})
+
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	newColl := make([]interface{}, 0, len(values))
+	for i, ok := range selected {
+		if ok {
+			newColl = append(newColl, values[i])
+		}
+	}
+
+	return newColl, nil
+}
+
+// parallelEach calls fn once for each index from 0 to size (exclusive), from a pool of workers goroutines.
+// It stops at the first panic or when ctx is done.
+func parallelEach(ctx context.Context, size, workers int, fn func(i int)) error {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		panicErr error
+		wg       sync.WaitGroup
+	)
+
+	call := func(i int) {
+		defer func() {
+			if r := recover(); r != nil {
+				once.Do(func() {
+					panicErr = &PanicError{Index: i, Value: r}
+					cancel()
+				})
+			}
+		}()
+
+		fn(i)
+	}
+
+	indexes := make(chan int)
+	for w := 0; w < min(workers, size); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				call(i)
+			}
+		}()
+	}
+
+	fed := 0
+feed:
+	for ; fed < size; fed++ {
+		select {
+		case <-workCtx.Done():
+			break feed
+		case indexes <- fed:
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	if panicErr != nil {
+		return panicErr
+	}
+
+	if fed < size {
+		return ctx.Err()
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelMap(t *testing.T) {
+	collection := make([]int, 1000)
+	for i := range collection {
+		collection[i] = i
+	}
+
+	newCollection, err := ParallelMap(context.Background(), collection, 8, func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	})
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	expect, _ := Map(collection, func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	})
+
+	if !reflect.DeepEqual(newCollection, expect) {
+		t.Error("ParallelMap should return the same elements than Map, in the same order")
+	}
+
+	if _, err = ParallelMap(context.Background(), 42, 1, nil); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if _, err = ParallelMap(context.Background(), collection, 1, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+
+	if newCollection, _ = ParallelMap(context.Background(), []int{}, 0, identity); len(newCollection) != 0 {
+		t.Errorf("New collection len should be 0, but was %d", len(newCollection))
+	}
+}
+
+func TestParallelMap_Collections(t *testing.T) {
+	keys, err := ParallelMap(context.Background(), map[string]int{"b": 2, "a": 1}, 2, func(obj interface{}) interface{} {
+		return obj.(KeyValue).Key
+	})
+
+	if expect := []interface{}{"a", "b"}; err != nil || !reflect.DeepEqual(keys, expect) {
+		t.Errorf("%v is not equal to %v, error %v", keys, expect, err)
+	}
+
+	selected, err := ParallelSelect(context.Background(), [4]int{1, 2, 3, 4}, 2, func(obj interface{}) bool {
+		return obj.(int)%2 == 0
+	})
+
+	if expect := []interface{}{2, 4}; err != nil || !reflect.DeepEqual(selected, expect) {
+		t.Errorf("%v is not equal to %v, error %v", selected, expect, err)
+	}
+
+	if _, err = ParallelSelect(context.Background(), nil, 2, nil); err != NilCollectionErr {
+		t.Errorf("Error should be %v, but was %v", NilCollectionErr, err)
+	}
+}
+
+func TestParallelMap_Panic(t *testing.T) {
+	var calls int32
+	_, err := ParallelMap(context.Background(), make([]int, 10000), 4, func(obj interface{}) interface{} {
+		if atomic.AddInt32(&calls, 1) == 10 {
+			panic("boom")
+		}
+
+		return obj
+	})
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Error should be a *PanicError, but was %v", err)
+	}
+
+	if panicErr.Value != "boom" {
+		t.Errorf("Panic value should be 'boom', but was %v", panicErr.Value)
+	}
+
+	if calls == 10000 {
+		t.Error("Remaining elements should be skipped after a panic")
+	}
+}
+
+func TestParallelMap_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls int32
+	_, err := ParallelMap(ctx, make([]int, 10000), 2, func(obj interface{}) interface{} {
+		if atomic.AddInt32(&calls, 1) == 5 {
+			cancel()
+		}
+
+		return obj
+	})
+
+	if err != context.Canceled {
+		t.Errorf("Error should be %v, but was %v", context.Canceled, err)
+	}
+}
+
+func TestParallelSelect(t *testing.T) {
+	collection := make([]int, 1000)
+	for i := range collection {
+		collection[i] = i
+	}
+
+	even, err := ParallelSelect(context.Background(), collection, 0, func(obj interface{}) bool {
+		return obj.(int)%2 == 0
+	})
+
+	if size := len(even); size != 500 {
+		t.Errorf("New collection len should be 500, but was %d", size)
+	}
+
+	for i, obj := range even {
+		if obj != i*2 {
+			t.Errorf("Element %d should be %d, but was %v", i, i*2, obj)
+		}
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = ParallelSelect(context.Background(), collection, 1, nil); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+}
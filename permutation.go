@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"iter"
+	"reflect"
+)
+
+// indexGenerator yields tuples of indexes (of the given size) over a collection of m elements.
+// It returns false if yield asked to stop.
+type indexGenerator func(m, size int, yield func(indexes []int) bool) bool
+
+// KCombination returns a slice of all combinations of k elements from the collection.
+// It is based on Ruby's `combination` method.
+// If collection is not a slice, then NotSliceErr is returned.
+func KCombination(collection interface{}, k int) ([]interface{}, error) {
+	return collectTuples(KCombinationSeq(collection, k))
+}
+
+// KCombinationSeq is the lazy form of KCombination.
+func KCombinationSeq(collection interface{}, k int) (iter.Seq[[]interface{}], error) {
+	return tupleSeq(collection, k, kCombinations)
+}
+
+// Permutation returns a slice of all permutations of n elements from the collection.
+// It is based on Ruby's `permutation` method.
+// If collection is not a slice, then NotSliceErr is returned.
+func Permutation(collection interface{}, n int) ([]interface{}, error) {
+	return collectTuples(PermutationSeq(collection, n))
+}
+
+// PermutationSeq is the lazy form of Permutation.
+func PermutationSeq(collection interface{}, n int) (iter.Seq[[]interface{}], error) {
+	return tupleSeq(collection, n, permutations)
+}
+
+// PowerSet returns a slice of all subsets of the collection, ordered by size.
+// If collection is not a slice, then NotSliceErr is returned.
+func PowerSet(collection interface{}) ([]interface{}, error) {
+	return collectTuples(PowerSetSeq(collection))
+}
+
+// PowerSetSeq is the lazy form of PowerSet.
+func PowerSetSeq(collection interface{}) (iter.Seq[[]interface{}], error) {
+	return tupleSeq(collection, 0, func(m, _ int, yield func([]int) bool) bool {
+		for k := 0; k <= m; k++ {
+			if !kCombinations(m, k, yield) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// RepeatedCombination returns a slice of all combinations with repetition of k elements from the collection.
+// It is based on Ruby's `repeated_combination` method.
+// If collection is not a slice, then NotSliceErr is returned.
+func RepeatedCombination(collection interface{}, k int) ([]interface{}, error) {
+	return collectTuples(RepeatedCombinationSeq(collection, k))
+}
+
+// RepeatedCombinationSeq is the lazy form of RepeatedCombination.
+func RepeatedCombinationSeq(collection interface{}, k int) (iter.Seq[[]interface{}], error) {
+	return tupleSeq(collection, k, repeatedCombinations)
+}
+
+// RepeatedPermutation returns a slice of all permutations with repetition of n elements from the collection.
+// It is based on Ruby's `repeated_permutation` method.
+// If collection is not a slice, then NotSliceErr is returned.
+func RepeatedPermutation(collection interface{}, n int) ([]interface{}, error) {
+	return collectTuples(RepeatedPermutationSeq(collection, n))
+}
+
+// RepeatedPermutationSeq is the lazy form of RepeatedPermutation.
+func RepeatedPermutationSeq(collection interface{}, n int) (iter.Seq[[]interface{}], error) {
+	return tupleSeq(collection, n, repeatedPermutations)
+}
+
+func collectTuples(seq iter.Seq[[]interface{}], err error) ([]interface{}, error) {
+	tuples := make([]interface{}, 0)
+	if err != nil {
+		return tuples, err
+	}
+
+	for tuple := range seq {
+		tuples = append(tuples, tuple)
+	}
+
+	return tuples, nil
+}
+
+func tupleSeq(collection interface{}, size int, generator indexGenerator) (iter.Seq[[]interface{}], error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	return func(yield func([]interface{}) bool) {
+		if size < 0 {
+			return
+		}
+
+		generator(collectionValue.Len(), size, func(indexes []int) bool {
+			tuple := make([]interface{}, len(indexes))
+			for i, index := range indexes {
+				tuple[i] = collectionValue.Index(index).Interface()
+			}
+
+			return yield(tuple)
+		})
+	}, nil
+}
+
+func kCombinations(m, k int, yield func([]int) bool) bool {
+	indexes := make([]int, k)
+
+	var generate func(depth, start int) bool
+	generate = func(depth, start int) bool {
+		if depth == k {
+			return yield(indexes)
+		}
+
+		for i := start; i <= m-(k-depth); i++ {
+			indexes[depth] = i
+			if !generate(depth+1, i+1) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return generate(0, 0)
+}
+
+func permutations(m, n int, yield func([]int) bool) bool {
+	indexes := make([]int, n)
+	used := make([]bool, m)
+
+	var generate func(depth int) bool
+	generate = func(depth int) bool {
+		if depth == n {
+			return yield(indexes)
+		}
+
+		for i := 0; i < m; i++ {
+			if used[i] {
+				continue
+			}
+
+			used[i], indexes[depth] = true, i
+			ok := generate(depth + 1)
+			used[i] = false
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return generate(0)
+}
+
+func repeatedCombinations(m, k int, yield func([]int) bool) bool {
+	indexes := make([]int, k)
+
+	var generate func(depth, start int) bool
+	generate = func(depth, start int) bool {
+		if depth == k {
+			return yield(indexes)
+		}
+
+		for i := start; i < m; i++ {
+			indexes[depth] = i
+			if !generate(depth+1, i) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return generate(0, 0)
+}
+
+func repeatedPermutations(m, n int, yield func([]int) bool) bool {
+	indexes := make([]int, n)
+
+	var generate func(depth int) bool
+	generate = func(depth int) bool {
+		if depth == n {
+			return yield(indexes)
+		}
+
+		for i := 0; i < m; i++ {
+			indexes[depth] = i
+			if !generate(depth + 1) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return generate(0)
+}
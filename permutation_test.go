@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKCombination(t *testing.T) {
+	c, _ := KCombination([]int{1, 2, 3, 4}, 2)
+	expect := []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{1, 3},
+		[]interface{}{1, 4},
+		[]interface{}{2, 3},
+		[]interface{}{2, 4},
+		[]interface{}{3, 4},
+	}
+
+	if !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	c, _ = KCombination([]int{1, 2}, 0)
+	expect = []interface{}{[]interface{}{}}
+
+	if !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	c, _ = KCombination([]int{1, 2}, 3)
+	if size := len(c); size != 0 {
+		t.Errorf("Slice len should be 0, but was %d", size)
+	}
+
+	if _, err := KCombination("Not a Collection", 1); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	p, _ := Permutation([]int{1, 2, 3}, 2)
+	expect := []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{1, 3},
+		[]interface{}{2, 1},
+		[]interface{}{2, 3},
+		[]interface{}{3, 1},
+		[]interface{}{3, 2},
+	}
+
+	if !reflect.DeepEqual(p, expect) {
+		t.Errorf("%v is not equal to %v", p, expect)
+	}
+
+	p, _ = Permutation([]int{1, 2, 3}, -1)
+	if size := len(p); size != 0 {
+		t.Errorf("Slice len should be 0, but was %d", size)
+	}
+
+	if _, err := Permutation("Not a Collection", 1); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	p, _ := PowerSet([]string{"a", "b", "c"})
+	expect := []interface{}{
+		[]interface{}{},
+		[]interface{}{"a"},
+		[]interface{}{"b"},
+		[]interface{}{"c"},
+		[]interface{}{"a", "b"},
+		[]interface{}{"a", "c"},
+		[]interface{}{"b", "c"},
+		[]interface{}{"a", "b", "c"},
+	}
+
+	if !reflect.DeepEqual(p, expect) {
+		t.Errorf("%v is not equal to %v", p, expect)
+	}
+
+	seq, _ := PowerSetSeq(make([]int, 40))
+	count := 0
+	for range seq {
+		if count++; count == 100 {
+			break
+		}
+	}
+
+	if count != 100 {
+		t.Errorf("Count should be 100, but was %d", count)
+	}
+}
+
+func TestRepeatedCombination(t *testing.T) {
+	c, _ := RepeatedCombination([]int{1, 2, 3}, 2)
+	expect := []interface{}{
+		[]interface{}{1, 1},
+		[]interface{}{1, 2},
+		[]interface{}{1, 3},
+		[]interface{}{2, 2},
+		[]interface{}{2, 3},
+		[]interface{}{3, 3},
+	}
+
+	if !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+}
+
+func TestRepeatedPermutation(t *testing.T) {
+	p, _ := RepeatedPermutation([]int{1, 2}, 2)
+	expect := []interface{}{
+		[]interface{}{1, 1},
+		[]interface{}{1, 2},
+		[]interface{}{2, 1},
+		[]interface{}{2, 2},
+	}
+
+	if !reflect.DeepEqual(p, expect) {
+		t.Errorf("%v is not equal to %v", p, expect)
+	}
+
+	if _, err := RepeatedPermutationSeq("Not a Collection", 2); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
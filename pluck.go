@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldNotFoundError is returned by Pluck, SortByField and GroupByField when an element of the
+// path can not be found.
+type FieldNotFoundError struct {
+	// Path is the full dotted path.
+	Path string
+	// Field is the element of the path that was not found.
+	Field string
+	// Type is the type where Field was looked for.
+	Type reflect.Type
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return fmt.Sprintf("field %q of path %q not found in %v", e.Field, e.Path, e.Type)
+}
+
+// GroupByField works like GroupBy, using the value at the specified path of each element as the key.
+// See Pluck for the path format.
+// If collection is not a slice, then NotSliceErr is returned.
+// If path can not be followed, then a *FieldNotFoundError is returned.
+// If the value at path can not be used in a map, then NotHashableErr is returned.
+func GroupByField(collection interface{}, path string) (map[interface{}][]interface{}, error) {
+	var err error
+	groups, groupErr := GroupBy(collection, func(obj interface{}) interface{} {
+		if err != nil {
+			return nil
+		}
+
+		var key interface{}
+		key, err = fieldByPath(obj, path)
+		return key
+	})
+
+	if err != nil {
+		return make(map[interface{}][]interface{}), err
+	}
+
+	return groups, groupErr
+}
+
+// Pluck returns a new slice with the value at the specified path of each element of the collection.
+// path is a dotted list of exported struct fields or map keys, such as "Address.City". Pointers and interfaces
+// are followed, and a nil one makes the value nil.
+// If collection is not a slice, then NotSliceErr is returned.
+// If path can not be followed, then a *FieldNotFoundError is returned.
+func Pluck(collection interface{}, path string) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	values := make([]interface{}, collectionValue.Len())
+	for i := range values {
+		value, err := fieldByPath(collectionValue.Index(i).Interface(), path)
+		if err != nil {
+			return make([]interface{}, 0), err
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// SortBy returns a new slice with the elements of the collection sorted by the values returned by mapFunc.
+// The sort is stable. Values returned by mapFunc must be integers, floats or strings of the same kind,
+// otherwise NotOrderedErr is returned. nil values are allowed, and their elements are sorted first.
+// It is based on Ruby's `sort_by` method.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func SortBy(collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	if mapFunc == nil {
+		return make([]interface{}, 0), NilMapFuncErr
+	}
+
+	keys := make([]interface{}, collectionValue.Len())
+	for i := range keys {
+		keys[i] = mapFunc(collectionValue.Index(i).Interface())
+	}
+
+	return sortByKeys(collectionValue, keys)
+}
+
+// SortByField works like SortBy, using the value at the specified path of each element.
+// See Pluck for the path format. Elements with a nil pointer along the path have a nil value, so they are sorted first.
+// If collection is not a slice, then NotSliceErr is returned.
+// If path can not be followed, then a *FieldNotFoundError is returned.
+func SortByField(collection interface{}, path string) ([]interface{}, error) {
+	keys, err := Pluck(collection, path)
+	if err != nil {
+		return keys, err
+	}
+
+	return sortByKeys(reflect.ValueOf(collection), keys)
+}
+
+// fieldByPath returns the value at the specified dotted path of obj.
+// When a nil pointer or interface is found, the rest of the path is checked against its type, so a
+// path that does not exist is reported even if the value is nil.
+func fieldByPath(obj interface{}, path string) (interface{}, error) {
+	fields := strings.Split(path, ".")
+	value := reflect.ValueOf(obj)
+	for i, field := range fields {
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return nil, checkPathType(value.Type(), path, fields[i:])
+			}
+
+			value = value.Elem()
+		}
+
+		// A nil interface, such as a nil element of a []interface{}, has no value or type to check.
+		if !value.IsValid() {
+			return nil, nil
+		}
+
+		notFound := &FieldNotFoundError{Path: path, Field: field, Type: value.Type()}
+
+		switch value.Kind() {
+		case reflect.Struct:
+			structField, ok := value.Type().FieldByName(field)
+			if !ok || !structField.IsExported() {
+				return nil, notFound
+			}
+
+			var err error
+			if value, err = value.FieldByIndexErr(structField.Index); err != nil {
+				// The field is promoted through a nil embedded pointer.
+				return nil, checkPathType(structField.Type, path, fields[i+1:])
+			}
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				return nil, notFound
+			}
+
+			value = value.MapIndex(reflect.ValueOf(field).Convert(value.Type().Key()))
+			if !value.IsValid() {
+				return nil, notFound
+			}
+		default:
+			return nil, notFound
+		}
+	}
+
+	if !value.IsValid() {
+		return nil, nil
+	}
+
+	return value.Interface(), nil
+}
+
+// checkPathType checks that fields can be followed from a value of type t.
+// Map keys and the contents of interfaces are only known at run time, so they are assumed to exist.
+func checkPathType(t reflect.Type, path string, fields []string) error {
+	for _, field := range fields {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Interface:
+			return nil
+		case reflect.Struct:
+			structField, ok := t.FieldByName(field)
+			if !ok || !structField.IsExported() {
+				return &FieldNotFoundError{Path: path, Field: field, Type: t}
+			}
+
+			t = structField.Type
+		case reflect.Map:
+			if t.Key().Kind() != reflect.String {
+				return &FieldNotFoundError{Path: path, Field: field, Type: t}
+			}
+
+			t = t.Elem()
+		default:
+			return &FieldNotFoundError{Path: path, Field: field, Type: t}
+		}
+	}
+
+	return nil
+}
+
+// sortByKeys returns the elements of sliceValue stably sorted by the key at the same position in keys.
+// nil keys are sorted first.
+func sortByKeys(sliceValue reflect.Value, keys []interface{}) ([]interface{}, error) {
+	indexes := make([]int, sliceValue.Len())
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	var err error
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := keys[indexes[i]], keys[indexes[j]]
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+
+		result, compareErr := compareValues(a, b)
+		if compareErr != nil {
+			err = compareErr
+		}
+
+		return result < 0
+	})
+
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	sorted := make([]interface{}, len(indexes))
+	for i, index := range indexes {
+		sorted[i] = sliceValue.Index(index).Interface()
+	}
+
+	return sorted, nil
+}
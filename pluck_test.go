@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testAddress struct {
+	City string
+	Zip  int
+}
+
+type testUser struct {
+	Name     string
+	Age      int
+	Address  *testAddress
+	Metadata map[string]interface{}
+	password string
+}
+
+func testUsers() []*testUser {
+	return []*testUser{
+		&testUser{"User 1", 30, &testAddress{"Lima", 3}, map[string]interface{}{"role": "admin"}, ""},
+		&testUser{"User 2", 20, &testAddress{"Bogota", 1}, map[string]interface{}{"role": "user"}, ""},
+		&testUser{"User 3", 25, nil, nil, ""},
+	}
+}
+
+func TestPluck(t *testing.T) {
+	users := testUsers()
+
+	names, err := Pluck(users, "Name")
+	if expect := []interface{}{"User 1", "User 2", "User 3"}; !reflect.DeepEqual(names, expect) {
+		t.Errorf("%v is not equal to %v", names, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	cities, _ := Pluck(users, "Address.City")
+	if expect := []interface{}{"Lima", "Bogota", nil}; !reflect.DeepEqual(cities, expect) {
+		t.Errorf("%v is not equal to %v", cities, expect)
+	}
+
+	roles, _ := Pluck(users[:2], "Metadata.role")
+	if expect := []interface{}{"admin", "user"}; !reflect.DeepEqual(roles, expect) {
+		t.Errorf("%v is not equal to %v", roles, expect)
+	}
+
+	names, err = Pluck([]interface{}{nil, users[0]}, "Name")
+	if expect := []interface{}{nil, "User 1"}; err != nil || !reflect.DeepEqual(names, expect) {
+		t.Errorf("%v is not equal to %v, error %v", names, expect, err)
+	}
+
+	var notFound *FieldNotFoundError
+	if _, err = Pluck(users, "Address.Country"); !errors.As(err, &notFound) || notFound.Field != "Country" {
+		t.Errorf("Error should be a *FieldNotFoundError for Country, but was %v", err)
+	}
+
+	if _, err = Pluck(users, "password"); !errors.As(err, &notFound) {
+		t.Errorf("Error should be a *FieldNotFoundError, but was %v", err)
+	}
+
+	if _, err = Pluck(users, "Name.First"); !errors.As(err, &notFound) {
+		t.Errorf("Error should be a *FieldNotFoundError, but was %v", err)
+	}
+
+	if _, err = Pluck(users[:2], "Metadata.missing"); !errors.As(err, &notFound) {
+		t.Errorf("Error should be a *FieldNotFoundError, but was %v", err)
+	}
+
+	if _, err = Pluck(users[2:], "Address.Ctiy"); !errors.As(err, &notFound) || notFound.Field != "Ctiy" {
+		t.Errorf("Error should be a *FieldNotFoundError for Ctiy, but was %v", err)
+	}
+
+	if _, err = Pluck(users[2:], "Address.City.Name"); !errors.As(err, &notFound) || notFound.Field != "Name" {
+		t.Errorf("Error should be a *FieldNotFoundError for Name, but was %v", err)
+	}
+
+	type embedded struct {
+		*testAddress
+	}
+
+	if _, err = Pluck([]embedded{{}}, "City.Name"); !errors.As(err, &notFound) || notFound.Field != "Name" {
+		t.Errorf("Error should be a *FieldNotFoundError for Name, but was %v", err)
+	}
+
+	cities, err = Pluck([]embedded{{}}, "City")
+	if expect := []interface{}{nil}; err != nil || !reflect.DeepEqual(cities, expect) {
+		t.Errorf("%v is not equal to %v, error %v", cities, expect, err)
+	}
+
+	if _, err = Pluck("Not a Collection", "Name"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	sorted, err := SortBy([]string{"ccc", "a", "bb", "d"}, func(obj interface{}) interface{} {
+		return len(obj.(string))
+	})
+
+	if expect := []interface{}{"a", "d", "bb", "ccc"}; !reflect.DeepEqual(sorted, expect) {
+		t.Errorf("%v is not equal to %v", sorted, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = SortBy([]interface{}{1, "a"}, identity); err != NotOrderedErr {
+		t.Errorf("Error should be %v, but was %v", NotOrderedErr, err)
+	}
+
+	sorted, _ = SortBy([]interface{}{2, nil, 1, nil}, identity)
+	if expect := []interface{}{nil, nil, 1, 2}; !reflect.DeepEqual(sorted, expect) {
+		t.Errorf("%v is not equal to %v", sorted, expect)
+	}
+
+	if _, err = SortBy([]int{1}, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+}
+
+func TestSortByField(t *testing.T) {
+	users := testUsers()
+
+	sorted, err := SortByField(users, "Age")
+	if expect := []interface{}{users[1], users[2], users[0]}; !reflect.DeepEqual(sorted, expect) {
+		t.Errorf("%v is not equal to %v", sorted, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	sorted, _ = SortByField(users[:2], "Address.Zip")
+	if expect := []interface{}{users[1], users[0]}; !reflect.DeepEqual(sorted, expect) {
+		t.Errorf("%v is not equal to %v", sorted, expect)
+	}
+
+	sorted, err = SortByField(users, "Address.City")
+	if expect := []interface{}{users[2], users[1], users[0]}; err != nil || !reflect.DeepEqual(sorted, expect) {
+		t.Errorf("%v is not equal to %v, error %v", sorted, expect, err)
+	}
+
+	var notFound *FieldNotFoundError
+	if _, err = SortByField(users, "Email"); !errors.As(err, &notFound) {
+		t.Errorf("Error should be a *FieldNotFoundError, but was %v", err)
+	}
+}
+
+func TestGroupByField(t *testing.T) {
+	users := testUsers()
+	users[2].Address = &testAddress{"Lima", 3}
+
+	groups, err := GroupByField(users, "Address.City")
+	expect := map[interface{}][]interface{}{
+		"Lima":   []interface{}{users[0], users[2]},
+		"Bogota": []interface{}{users[1]},
+	}
+
+	if !reflect.DeepEqual(groups, expect) {
+		t.Errorf("%v is not equal to %v", groups, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	var notFound *FieldNotFoundError
+	if _, err = GroupByField(users, "Address.Street"); !errors.As(err, &notFound) {
+		t.Errorf("Error should be a *FieldNotFoundError, but was %v", err)
+	}
+}
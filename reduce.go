@@ -0,0 +1,342 @@
+package utils
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// ReduceFunc is the function to be called by Reduce and Inject.
+// It receives the accumulated value (memo) and each element of the collection, and returns
+// the new accumulated value.
+type ReduceFunc func(memo interface{}, obj interface{}) interface{}
+
+// Number is the constraint for the types SumOf can add.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Inject calls the specified reduceFunc once for each element in the collection, starting with
+// initial as the accumulated value. It returns the final accumulated value.
+// It is based on Ruby's `inject` method with an initial value.
+// If collection is not a slice, then NotSliceErr is returned.
+// If reduceFunc is nil, then NilReduceFuncErr is returned.
+func Inject(collection interface{}, initial interface{}, reduceFunc ReduceFunc) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if reduceFunc == nil {
+		return nil, NilReduceFuncErr
+	}
+
+	memo := initial
+	for i := 0; i < collectionValue.Len(); i++ {
+		memo = reduceFunc(memo, collectionValue.Index(i).Interface())
+	}
+
+	return memo, nil
+}
+
+// Reduce works like Inject, but uses the first element of the collection as the initial value.
+// It is based on Ruby's `inject` method without an initial value.
+// If collection is not a slice, then NotSliceErr is returned.
+// If reduceFunc is nil, then NilReduceFuncErr is returned.
+// If collection is empty, then EmptyCollectionErr is returned.
+func Reduce(collection interface{}, reduceFunc ReduceFunc) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if reduceFunc == nil {
+		return nil, NilReduceFuncErr
+	}
+
+	if collectionValue.Len() == 0 {
+		return nil, EmptyCollectionErr
+	}
+
+	return Inject(collectionValue.Slice(1, collectionValue.Len()).Interface(), collectionValue.Index(0).Interface(), reduceFunc)
+}
+
+// Max returns the greatest element of the collection.
+// Elements must be integers, floats or strings of the same kind, otherwise NotOrderedErr is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+// If collection is empty, then EmptyCollectionErr is returned.
+func Max(collection interface{}) (interface{}, error) {
+	return MaxBy(collection, identity)
+}
+
+// MaxBy returns the element of the collection for which mapFunc returns the greatest value.
+// Values returned by mapFunc must be integers, floats or strings of the same kind, otherwise NotOrderedErr is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If collection is empty, then EmptyCollectionErr is returned.
+func MaxBy(collection interface{}, mapFunc MapFunc) (interface{}, error) {
+	return extremeBy(collection, mapFunc, 1)
+}
+
+// Min returns the smallest element of the collection.
+// Elements must be integers, floats or strings of the same kind, otherwise NotOrderedErr is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+// If collection is empty, then EmptyCollectionErr is returned.
+func Min(collection interface{}) (interface{}, error) {
+	return MinBy(collection, identity)
+}
+
+// MinBy returns the element of the collection for which mapFunc returns the smallest value.
+// Values returned by mapFunc must be integers, floats or strings of the same kind, otherwise NotOrderedErr is returned.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+// If collection is empty, then EmptyCollectionErr is returned.
+func MinBy(collection interface{}, mapFunc MapFunc) (interface{}, error) {
+	return extremeBy(collection, mapFunc, -1)
+}
+
+// Sum returns the sum of all elements of the collection. The result has the same type as the elements.
+// If collection is empty, 0 is returned.
+// If elements are not numbers of the same kind, then NotNumericErr is returned, even if the collection is empty
+// or has a single element.
+// If collection is not a slice, then NotSliceErr is returned.
+func Sum(collection interface{}) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	elemType := collectionValue.Type().Elem()
+	if elemType.Kind() != reflect.Interface && numericKind(elemType.Kind()) == reflect.Invalid {
+		return nil, NotNumericErr
+	}
+
+	switch collectionValue.Len() {
+	case 0:
+		if elemType.Kind() == reflect.Interface {
+			return 0, nil
+		}
+
+		return reflect.Zero(elemType).Interface(), nil
+	case 1:
+		obj := collectionValue.Index(0).Interface()
+		if numericKind(reflect.ValueOf(obj).Kind()) == reflect.Invalid {
+			return nil, NotNumericErr
+		}
+
+		return obj, nil
+	}
+
+	var err error
+	sum, _ := Reduce(collection, func(memo interface{}, obj interface{}) interface{} {
+		if err != nil {
+			return memo
+		}
+
+		var result interface{}
+		result, err = addValues(memo, obj)
+		return result
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sum, nil
+}
+
+// InjectOf calls the specified reduceFunc once for each element in the collection, starting with
+// initial as the accumulated value. It returns the final accumulated value.
+// reduceFunc must not be nil.
+func InjectOf[T, U any](collection []T, initial U, reduceFunc func(memo U, obj T) U) U {
+	memo := initial
+	for _, item := range collection {
+		memo = reduceFunc(memo, item)
+	}
+
+	return memo
+}
+
+// ReduceOf works like InjectOf, but uses the first element of the collection as the initial value.
+// If collection is empty, then EmptyCollectionErr is returned.
+// reduceFunc must not be nil.
+func ReduceOf[T any](collection []T, reduceFunc func(memo T, obj T) T) (T, error) {
+	if len(collection) == 0 {
+		var zero T
+		return zero, EmptyCollectionErr
+	}
+
+	return InjectOf(collection[1:], collection[0], reduceFunc), nil
+}
+
+// MaxOf returns the greatest element of the collection.
+// If collection is empty, then EmptyCollectionErr is returned.
+func MaxOf[T cmp.Ordered](collection []T) (T, error) {
+	return MaxByOf(collection, func(obj T) T { return obj })
+}
+
+// MaxByOf returns the element of the collection for which byFunc returns the greatest value.
+// If collection is empty, then EmptyCollectionErr is returned.
+// byFunc must not be nil.
+func MaxByOf[T any, K cmp.Ordered](collection []T, byFunc func(obj T) K) (T, error) {
+	return extremeByOf(collection, byFunc, 1)
+}
+
+// MinOf returns the smallest element of the collection.
+// If collection is empty, then EmptyCollectionErr is returned.
+func MinOf[T cmp.Ordered](collection []T) (T, error) {
+	return MinByOf(collection, func(obj T) T { return obj })
+}
+
+// MinByOf returns the element of the collection for which byFunc returns the smallest value.
+// If collection is empty, then EmptyCollectionErr is returned.
+// byFunc must not be nil.
+func MinByOf[T any, K cmp.Ordered](collection []T, byFunc func(obj T) K) (T, error) {
+	return extremeByOf(collection, byFunc, -1)
+}
+
+// SumOf returns the sum of all elements of the collection, or 0 if the collection is empty.
+func SumOf[T Number](collection []T) T {
+	return InjectOf(collection, T(0), func(memo T, obj T) T {
+		return memo + obj
+	})
+}
+
+func identity(obj interface{}) interface{} {
+	return obj
+}
+
+func extremeBy(collection interface{}, mapFunc MapFunc, sign int) (interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return nil, NotSliceErr
+	}
+
+	if mapFunc == nil {
+		return nil, NilMapFuncErr
+	}
+
+	type candidate struct {
+		obj, key interface{}
+	}
+
+	var err error
+	extreme, reduceErr := Inject(collection, nil, func(memo interface{}, obj interface{}) interface{} {
+		if err != nil {
+			return memo
+		}
+
+		current := candidate{obj, mapFunc(obj)}
+		if memo == nil {
+			if _, compareErr := compareValues(current.key, current.key); compareErr != nil {
+				err = compareErr
+			}
+
+			return current
+		}
+
+		best := memo.(candidate)
+		result, compareErr := compareValues(current.key, best.key)
+		if compareErr != nil {
+			err = compareErr
+			return best
+		}
+
+		if result*sign > 0 {
+			return current
+		}
+
+		return best
+	})
+
+	switch {
+	case reduceErr != nil:
+		return nil, reduceErr
+	case err != nil:
+		return nil, err
+	case extreme == nil:
+		return nil, EmptyCollectionErr
+	}
+
+	return extreme.(candidate).obj, nil
+}
+
+func extremeByOf[T any, K cmp.Ordered](collection []T, byFunc func(obj T) K, sign int) (T, error) {
+	if len(collection) == 0 {
+		var zero T
+		return zero, EmptyCollectionErr
+	}
+
+	best, bestKey := collection[0], byFunc(collection[0])
+	for _, item := range collection[1:] {
+		if key := byFunc(item); cmp.Compare(key, bestKey)*sign > 0 {
+			best, bestKey = item, key
+		}
+	}
+
+	return best, nil
+}
+
+// numericKind groups the numeric kinds into reflect.Int, reflect.Uint and reflect.Float64.
+// It returns reflect.Invalid for non numeric kinds.
+func numericKind(kind reflect.Kind) reflect.Kind {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+
+	return reflect.Invalid
+}
+
+func addValues(a, b interface{}) (interface{}, error) {
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !aValue.IsValid() || !bValue.IsValid() || aValue.Type() != bValue.Type() {
+		return nil, NotNumericErr
+	}
+
+	sum := reflect.New(aValue.Type()).Elem()
+	switch numericKind(aValue.Kind()) {
+	case reflect.Int:
+		sum.SetInt(aValue.Int() + bValue.Int())
+	case reflect.Uint:
+		sum.SetUint(aValue.Uint() + bValue.Uint())
+	case reflect.Float64:
+		sum.SetFloat(aValue.Float() + bValue.Float())
+	default:
+		return nil, NotNumericErr
+	}
+
+	return sum.Interface(), nil
+}
+
+func compareValues(a, b interface{}) (int, error) {
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !aValue.IsValid() || !bValue.IsValid() {
+		return 0, NotOrderedErr
+	}
+
+	aKind, bKind := numericKind(aValue.Kind()), numericKind(bValue.Kind())
+	if aValue.Kind() == reflect.String && bValue.Kind() == reflect.String {
+		return cmp.Compare(aValue.String(), bValue.String()), nil
+	}
+
+	if aKind != bKind {
+		return 0, NotOrderedErr
+	}
+
+	switch aKind {
+	case reflect.Int:
+		return cmp.Compare(aValue.Int(), bValue.Int()), nil
+	case reflect.Uint:
+		return cmp.Compare(aValue.Uint(), bValue.Uint()), nil
+	case reflect.Float64:
+		return cmp.Compare(aValue.Float(), bValue.Float()), nil
+	}
+
+	return 0, NotOrderedErr
+}
@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestInject(t *testing.T) {
+	sum, err := Inject([]int{1, 2, 3}, 10, func(memo interface{}, obj interface{}) interface{} {
+		return memo.(int) + obj.(int)
+	})
+
+	if sum != 16 {
+		t.Errorf("Sum should be 16, but was %v", sum)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if sum, _ = Inject([]int{}, 10, func(memo interface{}, obj interface{}) interface{} { return nil }); sum != 10 {
+		t.Errorf("Sum should be 10, but was %v", sum)
+	}
+
+	if _, err = Inject("Not a Collection", 0, nil); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if _, err = Inject([]int{}, 0, nil); err != NilReduceFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilReduceFuncErr, err)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	longest, err := Reduce([]string{"ab", "abcd", "abc"}, func(memo interface{}, obj interface{}) interface{} {
+		if len(obj.(string)) > len(memo.(string)) {
+			return obj
+		}
+
+		return memo
+	})
+
+	if longest != "abcd" {
+		t.Errorf("Longest should be 'abcd', but was %v", longest)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Reduce([]string{}, identityReduce); err != EmptyCollectionErr {
+		t.Errorf("Error should be %v, but was %v", EmptyCollectionErr, err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if sum, _ := Sum([]int{1, 2, 3}); sum != 6 {
+		t.Errorf("Sum should be 6, but was %v", sum)
+	}
+
+	if sum, _ := Sum([]float64{1.5, 2.5}); sum != 4.0 {
+		t.Errorf("Sum should be 4.0, but was %v", sum)
+	}
+
+	if sum, _ := Sum([]uint8{}); sum != uint8(0) {
+		t.Errorf("Sum should be 0, but was %v", sum)
+	}
+
+	if _, err := Sum([]interface{}{1, 2.5}); err != NotNumericErr {
+		t.Errorf("Error should be %v, but was %v", NotNumericErr, err)
+	}
+
+	for _, collection := range []interface{}{[]string{"a"}, []interface{}{"x"}, []string{}, []interface{}{nil}} {
+		if _, err := Sum(collection); err != NotNumericErr {
+			t.Errorf("Error should be %v for %#v, but was %v", NotNumericErr, collection, err)
+		}
+	}
+
+	if sum, err := Sum([]interface{}{7}); sum != 7 || err != nil {
+		t.Errorf("Sum should be 7, but was %v, error %v", sum, err)
+	}
+
+	if sum, _ := Sum([]interface{}{}); sum != 0 {
+		t.Errorf("Sum should be 0, but was %v", sum)
+	}
+
+	if _, err := Sum([]string{"a", "b"}); err != NotNumericErr {
+		t.Errorf("Error should be %v, but was %v", NotNumericErr, err)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if min, _ := Min([]int{3, 1, 2}); min != 1 {
+		t.Errorf("Min should be 1, but was %v", min)
+	}
+
+	if max, _ := Max([]string{"b", "c", "a"}); max != "c" {
+		t.Errorf("Max should be 'c', but was %v", max)
+	}
+
+	if _, err := Max([]interface{}{1, "a"}); err != NotOrderedErr {
+		t.Errorf("Error should be %v, but was %v", NotOrderedErr, err)
+	}
+
+	if _, err := Max([]struct{}{{}}); err != NotOrderedErr {
+		t.Errorf("Error should be %v, but was %v", NotOrderedErr, err)
+	}
+
+	if max, err := Max([]int{4}); max != 4 || err != nil {
+		t.Errorf("Max should be 4, but was %v, error %v", max, err)
+	}
+
+	if _, err := Min([]int{}); err != EmptyCollectionErr {
+		t.Errorf("Error should be %v, but was %v", EmptyCollectionErr, err)
+	}
+
+	collection := []*TestStruct{
+		&TestStruct{"Value B"},
+		&TestStruct{"Value A"},
+		&TestStruct{"Value C"},
+	}
+
+	byValue := func(obj interface{}) interface{} {
+		return obj.(*TestStruct).Value
+	}
+
+	if min, _ := MinBy(collection, byValue); min != collection[1] {
+		t.Errorf("Min should be %v, but was %v", collection[1], min)
+	}
+
+	if max, _ := MaxBy(collection, byValue); max != collection[2] {
+		t.Errorf("Max should be %v, but was %v", collection[2], max)
+	}
+
+	if _, err := MaxBy(collection, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+}
+
+func TestReduceOf(t *testing.T) {
+	product, err := ReduceOf([]int{2, 3, 4}, func(memo, obj int) int {
+		return memo * obj
+	})
+
+	if product != 24 {
+		t.Errorf("Product should be 24, but was %d", product)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = ReduceOf([]int{}, func(memo, obj int) int { return 0 }); err != EmptyCollectionErr {
+		t.Errorf("Error should be %v, but was %v", EmptyCollectionErr, err)
+	}
+
+	length := InjectOf([]string{"ab", "c"}, 0, func(memo int, obj string) int {
+		return memo + len(obj)
+	})
+
+	if length != 3 {
+		t.Errorf("Length should be 3, but was %d", length)
+	}
+}
+
+func TestSumMinMaxOf(t *testing.T) {
+	if sum := SumOf([]float64{1.5, 2.5}); sum != 4.0 {
+		t.Errorf("Sum should be 4.0, but was %v", sum)
+	}
+
+	if min, _ := MinOf([]int{3, 1, 2}); min != 1 {
+		t.Errorf("Min should be 1, but was %d", min)
+	}
+
+	if max, _ := MaxOf([]string{"b", "c", "a"}); max != "c" {
+		t.Errorf("Max should be 'c', but was %s", max)
+	}
+
+	if _, err := MaxOf([]int{}); err != EmptyCollectionErr {
+		t.Errorf("Error should be %v, but was %v", EmptyCollectionErr, err)
+	}
+
+	collection := []*TestStruct{
+		&TestStruct{"Value B"},
+		&TestStruct{"Value A"},
+	}
+
+	if min, _ := MinByOf(collection, func(obj *TestStruct) string { return obj.Value }); min != collection[1] {
+		t.Errorf("Min should be %v, but was %v", collection[1], min)
+	}
+}
+
+func identityReduce(memo interface{}, obj interface{}) interface{} {
+	return obj
+}
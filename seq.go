@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"iter"
+)
+
+// CompactSeq returns an iter.Seq with the elements of seq that are not nil.
+func CompactSeq(seq iter.Seq[interface{}]) iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for obj := range seq {
+			if obj != nil && !yield(obj) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns an iter.Seq that calls the specified mapFunc once for each element of seq, and yields
+// the returned values. mapFunc is only called when the returned iter.Seq is iterated.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func MapSeq(seq iter.Seq[interface{}], mapFunc MapFunc) (iter.Seq[interface{}], error) {
+	if mapFunc == nil {
+		return nil, NilMapFuncErr
+	}
+
+	return func(yield func(interface{}) bool) {
+		for obj := range seq {
+			if !yield(mapFunc(obj)) {
+				return
+			}
+		}
+	}, nil
+}
+
+// SelectSeq returns an iter.Seq with the elements of seq for which the specified selectFunc returns true.
+// selectFunc is only called when the returned iter.Seq is iterated.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func SelectSeq(seq iter.Seq[interface{}], selectFunc SelectFunc) (iter.Seq[interface{}], error) {
+	if selectFunc == nil {
+		return nil, NilSelectFuncErr
+	}
+
+	return func(yield func(interface{}) bool) {
+		for obj := range seq {
+			if selectFunc(obj) && !yield(obj) {
+				return
+			}
+		}
+	}, nil
+}
+
+// CollectionSeq returns an iter.Seq over the elements of the collection, so it can be used with MapSeq,
+// SelectSeq and CompactSeq. collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+func CollectionSeq(collection interface{}) (iter.Seq[interface{}], error) {
+	seq, _, err := collectionSeq(collection)
+	return seq, err
+}
+
+// CombinationSeqOf returns an iter.Seq with all combinations of elements from all seqs, in the same
+// order as Combination. Each seq is iterated once per combination of the previous ones, so they must
+// be able to be iterated several times.
+func CombinationSeqOf[T any](seqs ...iter.Seq[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+
+		combination := make([]T, len(seqs))
+
+		var generate func(depth int) bool
+		generate = func(depth int) bool {
+			for obj := range seqs[depth] {
+				combination[depth] = obj
+
+				if depth == len(seqs)-1 {
+					if !yield(append([]T{}, combination...)) {
+						return false
+					}
+				} else if !generate(depth + 1) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		generate(0)
+	}
+}
+
+// CompactSeqOf is the typed version of CompactSeq. Like CompactOf, only nil interfaces are removed.
+func CompactSeqOf[T any](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for obj := range seq {
+			if any(obj) != nil && !yield(obj) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeqOf is the typed version of MapSeq.
+// mapFunc must not be nil.
+func MapSeqOf[T, U any](seq iter.Seq[T], mapFunc func(obj T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for obj := range seq {
+			if !yield(mapFunc(obj)) {
+				return
+			}
+		}
+	}
+}
+
+// SelectSeqOf is the typed version of SelectSeq.
+// selectFunc must not be nil.
+func SelectSeqOf[T any](seq iter.Seq[T], selectFunc func(obj T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for obj := range seq {
+			if selectFunc(obj) && !yield(obj) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/isay-sosa/go-utils/arraylist"
+)
+
+func TestSeq(t *testing.T) {
+	collection := []interface{}{1, nil, 2, 3, nil, 4}
+
+	values, err := CollectionSeq(collection)
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	even, _ := SelectSeq(CompactSeq(values), func(obj interface{}) bool {
+		return obj.(int)%2 == 0
+	})
+
+	doubled, _ := MapSeq(even, func(obj interface{}) interface{} {
+		return obj.(int) * 2
+	})
+
+	result := make([]interface{}, 0)
+	for obj := range doubled {
+		result = append(result, obj)
+	}
+
+	if expect := []interface{}{4, 8}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("%v is not equal to %v", result, expect)
+	}
+
+	if _, err = CollectionSeq(42); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if _, err = MapSeq(values, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+
+	if _, err = SelectSeq(values, nil); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+}
+
+func TestSeq_ArrayList(t *testing.T) {
+	list := arraylist.New()
+	list.Add(&TestStruct{"Value A"}, nil, &TestStruct{"Value B"})
+
+	values, _ := MapSeq(CompactSeq(list.Values()), func(obj interface{}) interface{} {
+		return obj.(*TestStruct).Value
+	})
+
+	result := make([]interface{}, 0)
+	for obj := range values {
+		result = append(result, obj)
+	}
+
+	if expect := []interface{}{"Value A", "Value B"}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("%v is not equal to %v", result, expect)
+	}
+}
+
+func TestSeqOf(t *testing.T) {
+	calls := 0
+	lengths := MapSeqOf(slices.Values([]string{"a", "bb", "ccc", "dddd"}), func(obj string) int {
+		calls++
+		return len(obj)
+	})
+
+	odd := SelectSeqOf(lengths, func(obj int) bool {
+		return obj%2 == 1
+	})
+
+	for obj := range odd {
+		if obj == 3 {
+			break
+		}
+	}
+
+	if calls != 3 {
+		t.Errorf("mapFunc should be called 3 times, but was called %d times", calls)
+	}
+
+	var nilStruct *TestStruct
+	compact := slices.Collect(CompactSeqOf(slices.Values([]*TestStruct{nilStruct, &TestStruct{"Value A"}})))
+	if size := len(compact); size != 2 {
+		t.Errorf("Slice len should be 2, but was %d", size)
+	}
+
+	values := slices.Collect(CompactSeqOf(slices.Values([]interface{}{nil, "Value A", nil})))
+	if size := len(values); size != 1 {
+		t.Errorf("Slice len should be 1, but was %d", size)
+	}
+}
+
+func TestCombinationSeqOf(t *testing.T) {
+	c := slices.Collect(CombinationSeqOf(slices.Values([]int{1, 2}), slices.Values([]int{3, 4})))
+	if expect := [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}; !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if c = slices.Collect(CombinationSeqOf[int]()); len(c) != 0 {
+		t.Errorf("Slice len should be 0, but was %d", len(c))
+	}
+}
@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// Difference returns a new slice with the elements of collection that are not present in other.
+// Elements are compared like IsIncluded does, and the result contains no duplicates.
+// If collection or other are not slices, then NotSliceErr is returned.
+func Difference(collection interface{}, other interface{}) ([]interface{}, error) {
+	return setOperation(collection, other, func(inCollection, inOther bool) bool {
+		return inCollection && !inOther
+	})
+}
+
+// Intersection returns a new slice with the elements present in both collection and other.
+// It is based on Ruby's `&` operator. Elements are compared like IsIncluded does, and the result contains no duplicates.
+// If collection or other are not slices, then NotSliceErr is returned.
+func Intersection(collection interface{}, other interface{}) ([]interface{}, error) {
+	return setOperation(collection, other, func(inCollection, inOther bool) bool {
+		return inCollection && inOther
+	})
+}
+
+// SymmetricDifference returns a new slice with the elements present in only one of collection and other.
+// Elements are compared like IsIncluded does, and the result contains no duplicates.
+// If collection or other are not slices, then NotSliceErr is returned.
+func SymmetricDifference(collection interface{}, other interface{}) ([]interface{}, error) {
+	return setOperation(collection, other, func(inCollection, inOther bool) bool {
+		return inCollection != inOther
+	})
+}
+
+// Union returns a new slice with the elements present in collection or other.
+// It is based on Ruby's `|` operator. Elements are compared like IsIncluded does, and the result contains no duplicates.
+// If collection or other are not slices, then NotSliceErr is returned.
+func Union(collection interface{}, other interface{}) ([]interface{}, error) {
+	return setOperation(collection, other, func(inCollection, inOther bool) bool {
+		return true
+	})
+}
+
+// Uniq returns a new slice with the duplicated elements of the collection removed, keeping the first occurrence.
+// It is based on Ruby's `uniq` method. Elements are compared like IsIncluded does.
+// If collection is not a slice, then NotSliceErr is returned.
+func Uniq(collection interface{}) ([]interface{}, error) {
+	return UniqBy(collection, identity)
+}
+
+// UniqBy calls the specified mapFunc once for each element in the collection, and returns a new slice
+// with the elements for which mapFunc returned a duplicated value removed, keeping the first occurrence.
+// Values are compared like IsIncluded does.
+// If collection is not a slice, then NotSliceErr is returned.
+// If mapFunc is nil, then NilMapFuncErr is returned.
+func UniqBy(collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if collectionValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	if mapFunc == nil {
+		return make([]interface{}, 0), NilMapFuncErr
+	}
+
+	uniq := make([]interface{}, 0, collectionValue.Len())
+	seen := newElementSet()
+	for i := 0; i < collectionValue.Len(); i++ {
+		if obj := collectionValue.Index(i).Interface(); seen.add(mapFunc(obj)) {
+			uniq = append(uniq, obj)
+		}
+	}
+
+	return uniq, nil
+}
+
+// DifferenceOf is the typed version of Difference for comparable elements.
+func DifferenceOf[T comparable](collection []T, other []T) []T {
+	otherSet := setOf(other)
+	return uniqWhere(collection, func(obj T) bool {
+		return !containsKey(otherSet, obj)
+	})
+}
+
+// IntersectionOf is the typed version of Intersection for comparable elements.
+func IntersectionOf[T comparable](collection []T, other []T) []T {
+	otherSet := setOf(other)
+	return uniqWhere(collection, func(obj T) bool {
+		return containsKey(otherSet, obj)
+	})
+}
+
+// SymmetricDifferenceOf is the typed version of SymmetricDifference for comparable elements.
+func SymmetricDifferenceOf[T comparable](collection []T, other []T) []T {
+	return append(DifferenceOf(collection, other), DifferenceOf(other, collection)...)
+}
+
+// UnionOf is the typed version of Union for comparable elements.
+func UnionOf[T comparable](collection []T, other []T) []T {
+	return UniqOf(append(append(make([]T, 0, len(collection)+len(other)), collection...), other...))
+}
+
+// UniqOf is the typed version of Uniq for comparable elements.
+func UniqOf[T comparable](collection []T) []T {
+	return UniqByOf(collection, func(obj T) T { return obj })
+}
+
+// UniqByOf is the typed version of UniqBy.
+// mapFunc must not be nil.
+func UniqByOf[T any, K comparable](collection []T, mapFunc func(obj T) K) []T {
+	uniq := make([]T, 0, len(collection))
+	seen := make(map[K]struct{}, len(collection))
+	for _, item := range collection {
+		if key := mapFunc(item); !containsKey(seen, key) {
+			seen[key] = struct{}{}
+			uniq = append(uniq, item)
+		}
+	}
+
+	return uniq
+}
+
+func containsKey[K comparable](set map[K]struct{}, key K) bool {
+	_, ok := set[key]
+	return ok
+}
+
+func setOf[T comparable](collection []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(collection))
+	for _, item := range collection {
+		set[item] = struct{}{}
+	}
+
+	return set
+}
+
+// uniqWhere returns the elements of collection for which keep returns true, without duplicates.
+func uniqWhere[T comparable](collection []T, keep func(obj T) bool) []T {
+	result := make([]T, 0)
+	seen := make(map[T]struct{})
+	for _, item := range collection {
+		if keep(item) && !containsKey(seen, item) {
+			seen[item] = struct{}{}
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+func setOperation(collection interface{}, other interface{}, keep func(inCollection, inOther bool) bool) ([]interface{}, error) {
+	collectionValue, otherValue := reflect.ValueOf(collection), reflect.ValueOf(other)
+	if collectionValue.Kind() != reflect.Slice || otherValue.Kind() != reflect.Slice {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	collectionSet, otherSet := newElementSet(), newElementSet()
+	for i := 0; i < collectionValue.Len(); i++ {
+		collectionSet.add(collectionValue.Index(i).Interface())
+	}
+
+	for i := 0; i < otherValue.Len(); i++ {
+		otherSet.add(otherValue.Index(i).Interface())
+	}
+
+	result := make([]interface{}, 0)
+	seen := newElementSet()
+	for _, sliceValue := range []reflect.Value{collectionValue, otherValue} {
+		for i := 0; i < sliceValue.Len(); i++ {
+			obj := sliceValue.Index(i).Interface()
+			if keep(collectionSet.contains(obj), otherSet.contains(obj)) && seen.add(obj) {
+				result = append(result, obj)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// elementSet is a set of elements compared with reflect.DeepEqual.
+// Elements for which == gives the same result as reflect.DeepEqual are hashed; the rest are
+// kept in a slice and compared one by one.
+type elementSet struct {
+	hashed map[interface{}]struct{}
+	others []interface{}
+}
+
+func newElementSet() *elementSet {
+	return &elementSet{hashed: make(map[interface{}]struct{})}
+}
+
+// add adds obj to the set. It returns false if obj was already present.
+func (s *elementSet) add(obj interface{}) bool {
+	if s.contains(obj) {
+		return false
+	}
+
+	if isDeepHashable(reflect.ValueOf(obj)) {
+		s.hashed[obj] = struct{}{}
+	} else {
+		s.others = append(s.others, obj)
+	}
+
+	return true
+}
+
+func (s *elementSet) contains(obj interface{}) bool {
+	if isDeepHashable(reflect.ValueOf(obj)) {
+		_, ok := s.hashed[obj]
+		return ok
+	}
+
+	for _, other := range s.others {
+		if reflect.DeepEqual(other, obj) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDeepHashable returns true if value can be used as a map key and comparing it with == gives
+// the same result as reflect.DeepEqual.
+func isDeepHashable(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Invalid, reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Interface:
+		return value.IsNil() || isDeepHashable(value.Elem())
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if !isDeepHashable(value.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if !isDeepHashable(value.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDifference(t *testing.T) {
+	d, err := Difference([]int{1, 2, 2, 3, 4}, []int{2, 4})
+	if expect := []interface{}{1, 3}; !reflect.DeepEqual(d, expect) {
+		t.Errorf("%v is not equal to %v", d, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Difference([]int{1}, "Not a Collection"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	i, _ := Intersection([]string{"a", "b", "b", "c"}, []string{"c", "b", "z"})
+	if expect := []interface{}{"b", "c"}; !reflect.DeepEqual(i, expect) {
+		t.Errorf("%v is not equal to %v", i, expect)
+	}
+
+	collection := [][]int{{1, 2}, {3}}
+	i, _ = Intersection(collection, [][]int{{3}})
+	if expect := []interface{}{[]int{3}}; !reflect.DeepEqual(i, expect) {
+		t.Errorf("%v is not equal to %v", i, expect)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	s, _ := SymmetricDifference([]int{1, 2, 3}, []int{3, 4})
+	if expect := []interface{}{1, 2, 4}; !reflect.DeepEqual(s, expect) {
+		t.Errorf("%v is not equal to %v", s, expect)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	u, _ := Union([]int{1, 2, 1}, []int{2, 3})
+	if expect := []interface{}{1, 2, 3}; !reflect.DeepEqual(u, expect) {
+		t.Errorf("%v is not equal to %v", u, expect)
+	}
+}
+
+func TestUniq(t *testing.T) {
+	collection := []*TestStruct{
+		&TestStruct{"Value A"},
+		&TestStruct{"Value B"},
+		&TestStruct{"Value A"},
+	}
+
+	u, err := Uniq(collection)
+	if expect := []interface{}{collection[0], collection[1]}; !reflect.DeepEqual(u, expect) {
+		t.Errorf("%v is not equal to %v", u, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	for _, obj := range u {
+		if included, _ := IsIncluded(collection, obj); !included {
+			t.Errorf("%v should be included in the collection", obj)
+		}
+	}
+
+	u, _ = Uniq([]interface{}{1, int64(1), "1", 1, nil, nil})
+	if expect := []interface{}{1, int64(1), "1", nil}; !reflect.DeepEqual(u, expect) {
+		t.Errorf("%v is not equal to %v", u, expect)
+	}
+
+	u, _ = UniqBy([]string{"a", "bb", "cc", "d"}, func(obj interface{}) interface{} {
+		return len(obj.(string))
+	})
+
+	if expect := []interface{}{"a", "bb"}; !reflect.DeepEqual(u, expect) {
+		t.Errorf("%v is not equal to %v", u, expect)
+	}
+
+	if _, err = UniqBy([]int{1}, nil); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+}
+
+func TestSetOperationsOf(t *testing.T) {
+	if u := UniqOf([]int{3, 1, 3, 2, 1}); !reflect.DeepEqual(u, []int{3, 1, 2}) {
+		t.Errorf("%v is not equal to %v", u, []int{3, 1, 2})
+	}
+
+	if u := UnionOf([]int{1, 2}, []int{2, 3}); !reflect.DeepEqual(u, []int{1, 2, 3}) {
+		t.Errorf("%v is not equal to %v", u, []int{1, 2, 3})
+	}
+
+	if i := IntersectionOf([]int{1, 2, 2, 3}, []int{2, 3}); !reflect.DeepEqual(i, []int{2, 3}) {
+		t.Errorf("%v is not equal to %v", i, []int{2, 3})
+	}
+
+	if d := DifferenceOf([]int{1, 2, 3}, []int{2}); !reflect.DeepEqual(d, []int{1, 3}) {
+		t.Errorf("%v is not equal to %v", d, []int{1, 3})
+	}
+
+	if s := SymmetricDifferenceOf([]int{1, 2}, []int{2, 3}); !reflect.DeepEqual(s, []int{1, 3}) {
+		t.Errorf("%v is not equal to %v", s, []int{1, 3})
+	}
+
+	u := UniqByOf([]string{"a", "bb", "c"}, func(obj string) int { return len(obj) })
+	if !reflect.DeepEqual(u, []string{"a", "bb"}) {
+		t.Errorf("%v is not equal to %v", u, []string{"a", "bb"})
+	}
+}
@@ -6,10 +6,23 @@ import (
 )
 
 var (
-	NotSliceErr      = errors.New("collection value is not a Slice.")
-	NilMapFuncErr    = errors.New("map function is nil.")
-	NilSelectFuncErr = errors.New("select function is nil.")
-	ElemNotFoundErr  = errors.New("element not found.")
+	NotSliceErr        = errors.New("collection value is not a Slice.")
+	NilCollectionErr   = errors.New("collection value is nil.")
+	NotMapErr          = errors.New("collection value is not a Map.")
+	MapTypeErr         = errors.New("value does not match the map types.")
+	NilMapFuncErr      = errors.New("map function is nil.")
+	NilSelectFuncErr   = errors.New("select function is nil.")
+	NilReduceFuncErr   = errors.New("reduce function is nil.")
+	NilPairFuncErr     = errors.New("pair function is nil.")
+	NilEntryFuncErr    = errors.New("entry function is nil.")
+	ElemNotFoundErr    = errors.New("element not found.")
+	CountOverflowErr   = errors.New("count overflows int.")
+	EmptyCollectionErr = errors.New("collection is empty.")
+	NotNumericErr      = errors.New("elements are not numbers of the same kind.")
+	NotOrderedErr      = errors.New("elements can not be ordered.")
+	NotHashableErr     = errors.New("key is not hashable.")
+	InvalidSizeErr     = errors.New("size must be greater than 0.")
+	UnequalLengthErr   = errors.New("slices have different lengths.")
 )
 
 // MapFunc is the function to be called by Map.
@@ -38,16 +51,50 @@ func Combination(slices ...interface{}) ([]interface{}, error) {
 }
 
 // Compact returns a copy of the specified collection with all nil elements removed.
-// If slices is not a slice, then NotSliceErr is returned.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
 func Compact(collection interface{}) ([]interface{}, error) {
-	collectionValue := reflect.ValueOf(collection)
-	if collectionValue.Kind() != reflect.Slice {
-		return make([]interface{}, 0), NotSliceErr
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	compact := make([]interface{}, 0, size)
+	for item := range seq {
+		if item != nil {
+			compact = append(compact, item)
+		}
+	}
+
+	return compact, nil
+}
+
+// CompactDeep works like Compact, but also removes typed nils: nil pointers, maps, slices, channels
+// and functions stored in an interface.
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+func CompactDeep(collection interface{}) ([]interface{}, error) {
+	return CompactFunc(collection, isNil)
+}
+
+// CompactFunc works like Compact, but removes the elements for which the specified emptyFunc returns true.
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+// If emptyFunc is nil, then NilSelectFuncErr is returned.
+func CompactFunc(collection interface{}, emptyFunc SelectFunc) ([]interface{}, error) {
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	if emptyFunc == nil {
+		return make([]interface{}, 0), NilSelectFuncErr
 	}
 
-	compact := make([]interface{}, 0, collectionValue.Len())
-	for i := 0; i < collectionValue.Len(); i++ {
-		if item := collectionValue.Index(i).Interface(); item != nil {
+	compact := make([]interface{}, 0, size)
+	for item := range seq {
+		if !emptyFunc(item) {
 			compact = append(compact, item)
 		}
 	}
@@ -55,17 +102,29 @@ func Compact(collection interface{}) ([]interface{}, error) {
 	return compact, nil
 }
 
+// CompactZero works like Compact, but removes all zero values: nil, typed nils, empty strings, 0, false
+// and structs or arrays whose elements are all zero values.
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+func CompactZero(collection interface{}) ([]interface{}, error) {
+	return CompactFunc(collection, func(obj interface{}) bool {
+		return obj == nil || reflect.ValueOf(obj).IsZero()
+	})
+}
+
 // IsIncluded returns true if the specified element is present in the specified collection, otherwise returns false.
-// If collection is not a slice, then NotSliceErr is returned.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
 // If element is not found, then ElemNotFoundErr is returned.
 func IsIncluded(collection interface{}, obj interface{}) (bool, error) {
-	collectionValue := reflect.ValueOf(collection)
-	if collectionValue.Kind() != reflect.Slice {
-		return false, NotSliceErr
+	seq, _, err := collectionSeq(collection)
+	if err != nil {
+		return false, err
 	}
 
-	for i := 0; i < collectionValue.Len(); i++ {
-		if item := collectionValue.Index(i).Interface(); reflect.DeepEqual(item, obj) {
+	for item := range seq {
+		if reflect.DeepEqual(item, obj) {
 			return true, nil
 		}
 	}
@@ -75,21 +134,24 @@ func IsIncluded(collection interface{}, obj interface{}) (bool, error) {
 
 // Map calls the specified mapFunc once for each element in the collection.
 // It returns a new slice containing the values returned by the mapFunc.
-// If collection is not a slice, then NotSliceErr is returned.
+// collection can be a slice or an array, a map (whose entries are passed as KeyValue, sorted by key),
+// a string (whose runes are passed) or a receive channel (which is read until it is closed).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
 // If mapFunc is nil, then NilMapFuncErr is returned.
 func Map(collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
-	collectionValue := reflect.ValueOf(collection)
-	if collectionValue.Kind() != reflect.Slice {
-		return make([]interface{}, 0), NotSliceErr
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
 	}
 
 	if mapFunc == nil {
 		return make([]interface{}, 0), NilMapFuncErr
 	}
 
-	newColl := make([]interface{}, collectionValue.Len())
-	for i := 0; i < collectionValue.Len(); i++ {
-		newColl[i] = mapFunc(collectionValue.Index(i).Interface())
+	newColl := make([]interface{}, 0, size)
+	for obj := range seq {
+		newColl = append(newColl, mapFunc(obj))
 	}
 
 	return newColl, nil
@@ -97,21 +159,23 @@ func Map(collection interface{}, mapFunc MapFunc) ([]interface{}, error) {
 
 // Select calls the specified selectFunc once for each element in the collection.
 // It returns a new slice containing all elements of the collection for which the specified selectFunc returns true.
-// If collection is not a slice, then NotSliceErr is returned.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
 // If mapFunc is nil, then NilSelectFuncErr is returned.
 func Select(collection interface{}, selectFunc SelectFunc) ([]interface{}, error) {
-	collectionValue := reflect.ValueOf(collection)
-	if collectionValue.Kind() != reflect.Slice {
-		return make([]interface{}, 0), NotSliceErr
+	seq, size, err := collectionSeq(collection)
+	if err != nil {
+		return make([]interface{}, 0), err
 	}
 
 	if selectFunc == nil {
 		return make([]interface{}, 0), NilSelectFuncErr
 	}
 
-	newColl := make([]interface{}, 0, collectionValue.Len())
-	for i := 0; i < collectionValue.Len(); i++ {
-		if obj := collectionValue.Index(i).Interface(); selectFunc(obj) {
+	newColl := make([]interface{}, 0, size)
+	for obj := range seq {
+		if selectFunc(obj) {
 			newColl = append(newColl, obj)
 		}
 	}
@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -112,7 +113,7 @@ func TestIsIncluded(t *testing.T) {
 		t.Error("Error should be not found, but it was nil")
 	}
 
-	include, err = IsIncluded("Not Collection", nil)
+	include, err = IsIncluded(42, nil)
 	if include {
 		t.Error("nil should no tbe in collection, but it was")
 	}
@@ -148,7 +149,7 @@ func TestMap(t *testing.T) {
 		}
 	}
 
-	newCollection, err = Map("Not a Collection", nil)
+	newCollection, err = Map(42, nil)
 	if size := len(newCollection); size != 0 {
 		t.Errorf("New collection len should be 0, but was %d", size)
 	}
@@ -194,7 +195,7 @@ func TestSelect(t *testing.T) {
 		}
 	}
 
-	newCollection, err = Select("Not a Collection", nil)
+	newCollection, err = Select(42, nil)
 	if size := len(newCollection); size != 0 {
 		t.Errorf("New collection len should be 0, but was %d", size)
 	}
@@ -212,3 +213,73 @@ func TestSelect(t *testing.T) {
 		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
 	}
 }
+
+func TestCompactDeep(t *testing.T) {
+	var nilStruct *TestStruct
+	var nilSlice []int
+	var nilMap map[string]int
+
+	slice := []interface{}{
+		&TestStruct{"Value A"},
+		nil,
+		nilStruct,
+		nilSlice,
+		nilMap,
+		[]int{},
+		0,
+	}
+
+	c, _ := Compact(slice)
+	if size := len(c); size != 6 {
+		t.Errorf("Compact len should be 6, but was %d", size)
+	}
+
+	c, err := CompactDeep(slice)
+	if expect := []interface{}{&TestStruct{"Value A"}, []int{}, 0}; !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if c, _ = CompactDeep([]*TestStruct{nilStruct, &TestStruct{}}); len(c) != 1 {
+		t.Errorf("Slice len should be 1, but was %d", len(c))
+	}
+}
+
+func TestCompactZero(t *testing.T) {
+	var nilStruct *TestStruct
+	slice := []interface{}{"", "a", 0, 1, false, true, TestStruct{}, TestStruct{"Value A"}, nil, nilStruct, [2]int{}}
+
+	c, err := CompactZero(slice)
+	if expect := []interface{}{"a", 1, true, TestStruct{"Value A"}}; !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = CompactZero(42); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	c, err := CompactFunc([]string{"a", " ", "b", ""}, func(obj interface{}) bool {
+		return strings.TrimSpace(obj.(string)) == ""
+	})
+
+	if expect := []interface{}{"a", "b"}; !reflect.DeepEqual(c, expect) {
+		t.Errorf("%v is not equal to %v", c, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = CompactFunc([]string{}, nil); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+}
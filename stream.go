@@ -0,0 +1,338 @@
+package utils
+
+import (
+	"iter"
+
+	"github.com/isay-sosa/go-utils/arraylist"
+)
+
+// Stream is a lazy pipeline over a collection. Intermediate operations such as Map or Select
+// return a new Stream without doing any work; the elements are processed in a single pass when
+// a terminal operation such as Collect or Reduce is called.
+//
+//	names, err := StreamFromSeq(list.Values()).
+//		Select(isAdult).
+//		Map(name).
+//		Take(10).
+//		Collect()
+//
+// Errors, such as a nil function, are kept by the Stream and returned by the terminal operation.
+type Stream struct {
+	seq iter.Seq[interface{}]
+	err error
+}
+
+// NewStream returns a *Stream over the elements of the collection.
+// collection can be a slice, an array, a map, a string or a channel (see Map).
+// If collection is nil, then NilCollectionErr is returned.
+// If collection is of any other kind, then NotSliceErr is returned.
+func NewStream(collection interface{}) (*Stream, error) {
+	seq, err := CollectionSeq(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return StreamFromSeq(seq), nil
+}
+
+// StreamFromArrayList returns a *Stream over the elements of the list.
+func StreamFromArrayList(list *arraylist.ArrayList) *Stream {
+	return StreamFromSeq(list.Values())
+}
+
+// StreamFromSeq returns a *Stream over the elements of seq.
+func StreamFromSeq(seq iter.Seq[interface{}]) *Stream {
+	return &Stream{seq: seq}
+}
+
+// Chunk returns a Stream that yields consecutive groups of size elements, as a []interface{}.
+// The last group contains the remaining elements, so it can be smaller.
+// If size is less than 1, the terminal operation returns InvalidSizeErr.
+func (s *Stream) Chunk(size int) *Stream {
+	if size < 1 {
+		return s.fail(InvalidSizeErr)
+	}
+
+	return s.then(func(yield func(interface{}) bool) {
+		chunk := make([]interface{}, 0, size)
+		for obj := range s.seq {
+			if chunk = append(chunk, obj); len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+
+				chunk = make([]interface{}, 0, size)
+			}
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	})
+}
+
+// Drop returns a Stream that skips the first n elements.
+func (s *Stream) Drop(n int) *Stream {
+	return s.then(func(yield func(interface{}) bool) {
+		i := 0
+		for obj := range s.seq {
+			if i++; i > n && !yield(obj) {
+				return
+			}
+		}
+	})
+}
+
+// DropWhile returns a Stream that skips the elements while selectFunc returns true.
+// If selectFunc is nil, the terminal operation returns NilSelectFuncErr.
+func (s *Stream) DropWhile(selectFunc SelectFunc) *Stream {
+	if selectFunc == nil {
+		return s.fail(NilSelectFuncErr)
+	}
+
+	return s.then(func(yield func(interface{}) bool) {
+		dropping := true
+		for obj := range s.seq {
+			if dropping = dropping && selectFunc(obj); !dropping && !yield(obj) {
+				return
+			}
+		}
+	})
+}
+
+// Map returns a Stream that yields the values returned by mapFunc for each element.
+// If mapFunc is nil, the terminal operation returns NilMapFuncErr.
+func (s *Stream) Map(mapFunc MapFunc) *Stream {
+	seq, err := MapSeq(s.seq, mapFunc)
+	if err != nil {
+		return s.fail(err)
+	}
+
+	return s.then(seq)
+}
+
+// Reject returns a Stream that yields the elements for which selectFunc returns false.
+// If selectFunc is nil, the terminal operation returns NilSelectFuncErr.
+func (s *Stream) Reject(selectFunc SelectFunc) *Stream {
+	if selectFunc == nil {
+		return s.fail(NilSelectFuncErr)
+	}
+
+	return s.Select(func(obj interface{}) bool {
+		return !selectFunc(obj)
+	})
+}
+
+// Select returns a Stream that yields the elements for which selectFunc returns true.
+// If selectFunc is nil, the terminal operation returns NilSelectFuncErr.
+func (s *Stream) Select(selectFunc SelectFunc) *Stream {
+	seq, err := SelectSeq(s.seq, selectFunc)
+	if err != nil {
+		return s.fail(err)
+	}
+
+	return s.then(seq)
+}
+
+// Take returns a Stream that yields only the first n elements.
+// Once n elements are yielded, the source is not iterated anymore.
+func (s *Stream) Take(n int) *Stream {
+	return s.then(func(yield func(interface{}) bool) {
+		if n < 1 {
+			return
+		}
+
+		i := 0
+		for obj := range s.seq {
+			if !yield(obj) {
+				return
+			}
+
+			if i++; i == n {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile returns a Stream that yields the elements while selectFunc returns true.
+// If selectFunc is nil, the terminal operation returns NilSelectFuncErr.
+func (s *Stream) TakeWhile(selectFunc SelectFunc) *Stream {
+	if selectFunc == nil {
+		return s.fail(NilSelectFuncErr)
+	}
+
+	return s.then(func(yield func(interface{}) bool) {
+		for obj := range s.seq {
+			if !selectFunc(obj) || !yield(obj) {
+				return
+			}
+		}
+	})
+}
+
+// Uniq returns a Stream that skips the elements already yielded. Elements are compared like IsIncluded does.
+func (s *Stream) Uniq() *Stream {
+	return s.then(func(yield func(interface{}) bool) {
+		seen := newElementSet()
+		for obj := range s.seq {
+			if seen.add(obj) && !yield(obj) {
+				return
+			}
+		}
+	})
+}
+
+// All returns true if selectFunc returns true for every element. It stops at the first false.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func (s *Stream) All(selectFunc SelectFunc) (bool, error) {
+	if selectFunc == nil {
+		return false, NilSelectFuncErr
+	}
+
+	found, err := s.Any(func(obj interface{}) bool {
+		return !selectFunc(obj)
+	})
+
+	return !found && err == nil, err
+}
+
+// Any returns true if selectFunc returns true for at least one element. It stops at the first true.
+// If selectFunc is nil, then NilSelectFuncErr is returned.
+func (s *Stream) Any(selectFunc SelectFunc) (bool, error) {
+	if selectFunc == nil {
+		return false, NilSelectFuncErr
+	}
+
+	if s.err != nil {
+		return false, s.err
+	}
+
+	for obj := range s.seq {
+		if selectFunc(obj) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Collect returns a slice with the elements of the Stream.
+func (s *Stream) Collect() ([]interface{}, error) {
+	if s.err != nil {
+		return make([]interface{}, 0), s.err
+	}
+
+	collection := make([]interface{}, 0)
+	for obj := range s.seq {
+		collection = append(collection, obj)
+	}
+
+	return collection, nil
+}
+
+// Count returns the number of elements of the Stream.
+func (s *Stream) Count() (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	count := 0
+	for range s.seq {
+		count++
+	}
+
+	return count, nil
+}
+
+// First returns the first element of the Stream, without iterating the rest of them.
+// If the Stream has no elements, then EmptyCollectionErr is returned.
+func (s *Stream) First() (interface{}, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	for obj := range s.seq {
+		return obj, nil
+	}
+
+	return nil, EmptyCollectionErr
+}
+
+// Inject works like the Inject function over the elements of the Stream.
+// If reduceFunc is nil, then NilReduceFuncErr is returned.
+func (s *Stream) Inject(initial interface{}, reduceFunc ReduceFunc) (interface{}, error) {
+	if reduceFunc == nil {
+		return nil, NilReduceFuncErr
+	}
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	memo := initial
+	for obj := range s.seq {
+		memo = reduceFunc(memo, obj)
+	}
+
+	return memo, nil
+}
+
+// Reduce works like the Reduce function over the elements of the Stream.
+// If reduceFunc is nil, then NilReduceFuncErr is returned.
+// If the Stream has no elements, then EmptyCollectionErr is returned.
+func (s *Stream) Reduce(reduceFunc ReduceFunc) (interface{}, error) {
+	if reduceFunc == nil {
+		return nil, NilReduceFuncErr
+	}
+
+	first := true
+	memo, err := s.Inject(nil, func(memo interface{}, obj interface{}) interface{} {
+		if first {
+			first = false
+			return obj
+		}
+
+		return reduceFunc(memo, obj)
+	})
+
+	if err == nil && first {
+		return nil, EmptyCollectionErr
+	}
+
+	return memo, err
+}
+
+// Seq returns the elements of the Stream as an iter.Seq.
+// If the Stream has an error, the returned iter.Seq yields no elements.
+func (s *Stream) Seq() iter.Seq[interface{}] {
+	if s.err != nil {
+		return func(yield func(interface{}) bool) {}
+	}
+
+	return s.seq
+}
+
+// ToArrayList returns a new *arraylist.ArrayList with the elements of the Stream.
+func (s *Stream) ToArrayList() (*arraylist.ArrayList, error) {
+	collection, err := s.Collect()
+	if err != nil {
+		return nil, err
+	}
+
+	list := arraylist.New()
+	list.Add(collection...)
+	return list, nil
+}
+
+func (s *Stream) fail(err error) *Stream {
+	if s.err != nil {
+		return s
+	}
+
+	return &Stream{seq: s.seq, err: err}
+}
+
+func (s *Stream) then(seq iter.Seq[interface{}]) *Stream {
+	return &Stream{seq: seq, err: s.err}
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/isay-sosa/go-utils/arraylist"
+)
+
+func isEven(obj interface{}) bool {
+	return obj.(int)%2 == 0
+}
+
+func TestStream(t *testing.T) {
+	calls := 0
+	stream, err := NewStream([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	result, err := stream.
+		Select(isEven).
+		Map(func(obj interface{}) interface{} {
+			calls++
+			return obj.(int) * 10
+		}).
+		Take(3).
+		Collect()
+
+	if expect := []interface{}{20, 40, 60}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("%v is not equal to %v", result, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if calls != 3 {
+		t.Errorf("mapFunc should be called 3 times, but was called %d times", calls)
+	}
+
+	if _, err = NewStream(42); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestStream_Operations(t *testing.T) {
+	stream, _ := NewStream([]int{1, 2, 2, 3, 4, 1, 5})
+
+	result, _ := stream.Reject(isEven).Collect()
+	if expect := []interface{}{1, 3, 1, 5}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("Reject: %v is not equal to %v", result, expect)
+	}
+
+	result, _ = stream.Drop(5).Collect()
+	if expect := []interface{}{1, 5}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("Drop: %v is not equal to %v", result, expect)
+	}
+
+	lessThan3 := func(obj interface{}) bool { return obj.(int) < 3 }
+
+	result, _ = stream.TakeWhile(lessThan3).Collect()
+	if expect := []interface{}{1, 2, 2}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("TakeWhile: %v is not equal to %v", result, expect)
+	}
+
+	result, _ = stream.DropWhile(lessThan3).Collect()
+	if expect := []interface{}{3, 4, 1, 5}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("DropWhile: %v is not equal to %v", result, expect)
+	}
+
+	result, _ = stream.Uniq().Collect()
+	if expect := []interface{}{1, 2, 3, 4, 5}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("Uniq: %v is not equal to %v", result, expect)
+	}
+
+	result, _ = stream.Chunk(3).Collect()
+	expect := []interface{}{
+		[]interface{}{1, 2, 2},
+		[]interface{}{3, 4, 1},
+		[]interface{}{5},
+	}
+
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("Chunk: %v is not equal to %v", result, expect)
+	}
+}
+
+func TestStream_Terminals(t *testing.T) {
+	list := arraylist.New()
+	list.Add(1, 2, 3, 4)
+	stream := StreamFromArrayList(list)
+
+	if count, _ := stream.Count(); count != 4 {
+		t.Errorf("Count should be 4, but was %d", count)
+	}
+
+	if first, _ := stream.Select(isEven).First(); first != 2 {
+		t.Errorf("First should be 2, but was %v", first)
+	}
+
+	if _, err := stream.Take(0).First(); err != EmptyCollectionErr {
+		t.Errorf("Error should be %v, but was %v", EmptyCollectionErr, err)
+	}
+
+	if any, _ := stream.Any(isEven); !any {
+		t.Error("Any should be true, but was false")
+	}
+
+	if all, _ := stream.All(isEven); all {
+		t.Error("All should be false, but was true")
+	}
+
+	sum := func(memo interface{}, obj interface{}) interface{} {
+		return memo.(int) + obj.(int)
+	}
+
+	if total, _ := stream.Reduce(sum); total != 10 {
+		t.Errorf("Total should be 10, but was %v", total)
+	}
+
+	if total, _ := stream.Inject(5, sum); total != 15 {
+		t.Errorf("Total should be 15, but was %v", total)
+	}
+
+	if _, err := stream.Drop(10).Reduce(sum); err != EmptyCollectionErr {
+		t.Errorf("Error should be %v, but was %v", EmptyCollectionErr, err)
+	}
+
+	newList, _ := stream.Select(isEven).ToArrayList()
+	if expect := []interface{}{2, 4}; !reflect.DeepEqual(newList.Slice(), expect) {
+		t.Errorf("%v is not equal to %v", newList.Slice(), expect)
+	}
+}
+
+func TestStream_Errors(t *testing.T) {
+	stream := StreamFromSeq(func(yield func(interface{}) bool) {
+		yield(1)
+	})
+
+	if _, err := stream.Map(nil).Select(isEven).Collect(); err != NilMapFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilMapFuncErr, err)
+	}
+
+	if _, err := stream.Select(nil).Map(nil).Count(); err != NilSelectFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilSelectFuncErr, err)
+	}
+
+	if _, err := stream.Chunk(0).ToArrayList(); err != InvalidSizeErr {
+		t.Errorf("Error should be %v, but was %v", InvalidSizeErr, err)
+	}
+
+	if _, err := stream.Reduce(nil); err != NilReduceFuncErr {
+		t.Errorf("Error should be %v, but was %v", NilReduceFuncErr, err)
+	}
+
+	for range stream.TakeWhile(nil).Seq() {
+		t.Error("Seq should not yield elements when the Stream has an error")
+	}
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// ZipMode tells Zip, Unzip and their typed versions what to do with slices of different lengths.
+type ZipMode int
+
+const (
+	// ZipTruncate stops at the end of the shortest slice.
+	ZipTruncate ZipMode = iota
+	// ZipPad continues until the end of the longest slice, filling the missing elements with nil
+	// (or the zero value in the typed versions).
+	ZipPad
+	// ZipStrict returns UnequalLengthErr if the slices have different lengths.
+	ZipStrict
+)
+
+// Pair holds two elements of different types. It is returned by Zip2.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Transpose returns a new slice where the rows of the collection (a slice of slices or arrays) are columns.
+// It is based on Ruby's `transpose` method.
+// If collection or any of its elements are not slices or arrays, then NotSliceErr is returned.
+// If its elements have different lengths, then UnequalLengthErr is returned.
+func Transpose(collection interface{}) ([]interface{}, error) {
+	return Unzip(collection, ZipStrict)
+}
+
+// Unzip is the inverse of Zip. It receives a collection of tuples (slices or arrays) and returns a slice
+// with one slice per position, handling tuples of different lengths as specified by mode.
+// If collection or any of its elements are not slices or arrays, then NotSliceErr is returned.
+func Unzip(collection interface{}, mode ZipMode) ([]interface{}, error) {
+	collectionValue := reflect.ValueOf(collection)
+	if kind := collectionValue.Kind(); kind != reflect.Slice && kind != reflect.Array {
+		return make([]interface{}, 0), NotSliceErr
+	}
+
+	tuples := make([]interface{}, collectionValue.Len())
+	for i := range tuples {
+		tuples[i] = collectionValue.Index(i).Interface()
+	}
+
+	return Zip(mode, tuples...)
+}
+
+// Zip combines the slices element-wise. It returns a slice of tuples, where the tuple i contains the element i
+// of each slice, handling slices of different lengths as specified by mode.
+// It is based on Ruby's `zip` method.
+// If any of the slices is not a slice or an array, then NotSliceErr is returned.
+// If mode is ZipStrict and the slices have different lengths, then UnequalLengthErr is returned.
+func Zip(mode ZipMode, slices ...interface{}) ([]interface{}, error) {
+	values := make([]reflect.Value, len(slices))
+	lengths := make([]int, len(slices))
+	for i, slice := range slices {
+		values[i] = reflect.ValueOf(slice)
+		if kind := values[i].Kind(); kind != reflect.Slice && kind != reflect.Array {
+			return make([]interface{}, 0), NotSliceErr
+		}
+
+		lengths[i] = values[i].Len()
+	}
+
+	size, err := zipLength(lengths, mode)
+	if err != nil {
+		return make([]interface{}, 0), err
+	}
+
+	zipped := make([]interface{}, size)
+	for i := range zipped {
+		tuple := make([]interface{}, len(values))
+		for j, sliceValue := range values {
+			if i < sliceValue.Len() {
+				tuple[j] = sliceValue.Index(i).Interface()
+			}
+		}
+
+		zipped[i] = tuple
+	}
+
+	return zipped, nil
+}
+
+// TransposeOf is the typed version of Transpose.
+// If the slices have different lengths, then UnequalLengthErr is returned.
+func TransposeOf[T any](collection [][]T) ([][]T, error) {
+	return ZipOf(ZipStrict, collection...)
+}
+
+// Unzip2 is the inverse of Zip2.
+func Unzip2[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	first, second := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, pair := range pairs {
+		first[i], second[i] = pair.First, pair.Second
+	}
+
+	return first, second
+}
+
+// Zip2 combines two slices of different types element-wise into a slice of Pair.
+// If mode is ZipStrict and the slices have different lengths, then UnequalLengthErr is returned.
+func Zip2[A, B any](first []A, second []B, mode ZipMode) ([]Pair[A, B], error) {
+	size, err := zipLength([]int{len(first), len(second)}, mode)
+	if err != nil {
+		return make([]Pair[A, B], 0), err
+	}
+
+	pairs := make([]Pair[A, B], size)
+	for i := range pairs {
+		if i < len(first) {
+			pairs[i].First = first[i]
+		}
+
+		if i < len(second) {
+			pairs[i].Second = second[i]
+		}
+	}
+
+	return pairs, nil
+}
+
+// ZipOf is the typed version of Zip. Missing elements are filled with the zero value of T when mode is ZipPad.
+// If mode is ZipStrict and the slices have different lengths, then UnequalLengthErr is returned.
+func ZipOf[T any](mode ZipMode, slices ...[]T) ([][]T, error) {
+	lengths := make([]int, len(slices))
+	for i, slice := range slices {
+		lengths[i] = len(slice)
+	}
+
+	size, err := zipLength(lengths, mode)
+	if err != nil {
+		return make([][]T, 0), err
+	}
+
+	zipped := make([][]T, size)
+	for i := range zipped {
+		zipped[i] = make([]T, len(slices))
+		for j, slice := range slices {
+			if i < len(slice) {
+				zipped[i][j] = slice[i]
+			}
+		}
+	}
+
+	return zipped, nil
+}
+
+// zipLength returns the number of tuples to build for slices of the specified lengths.
+func zipLength(lengths []int, mode ZipMode) (int, error) {
+	if len(lengths) == 0 {
+		return 0, nil
+	}
+
+	shortest, longest := lengths[0], lengths[0]
+	for _, length := range lengths[1:] {
+		shortest, longest = min(shortest, length), max(longest, length)
+	}
+
+	switch mode {
+	case ZipPad:
+		return longest, nil
+	case ZipStrict:
+		if shortest != longest {
+			return 0, UnequalLengthErr
+		}
+	}
+
+	return shortest, nil
+}
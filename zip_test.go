@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	z, err := Zip(ZipTruncate, []int{1, 2, 3}, []string{"a", "b"})
+	expect := []interface{}{
+		[]interface{}{1, "a"},
+		[]interface{}{2, "b"},
+	}
+
+	if !reflect.DeepEqual(z, expect) {
+		t.Errorf("%v is not equal to %v", z, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	z, _ = Zip(ZipPad, []int{1, 2, 3}, []string{"a", "b"})
+	expect = []interface{}{
+		[]interface{}{1, "a"},
+		[]interface{}{2, "b"},
+		[]interface{}{3, nil},
+	}
+
+	if !reflect.DeepEqual(z, expect) {
+		t.Errorf("%v is not equal to %v", z, expect)
+	}
+
+	if _, err = Zip(ZipStrict, []int{1, 2, 3}, []string{"a", "b"}); err != UnequalLengthErr {
+		t.Errorf("Error should be %v, but was %v", UnequalLengthErr, err)
+	}
+
+	if _, err = Zip(ZipTruncate, []int{1}, "Not a Collection"); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+
+	if z, _ = Zip(ZipPad); len(z) != 0 {
+		t.Errorf("Slice len should be 0, but was %d", len(z))
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	zipped, _ := Zip(ZipStrict, []int{1, 2}, []string{"a", "b"})
+
+	u, err := Unzip(zipped, ZipStrict)
+	expect := []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{"a", "b"},
+	}
+
+	if !reflect.DeepEqual(u, expect) {
+		t.Errorf("%v is not equal to %v", u, expect)
+	}
+
+	if err != nil {
+		t.Errorf("Error should be nil, but was %s", err.Error())
+	}
+
+	if _, err = Unzip([]int{1}, ZipStrict); err != NotSliceErr {
+		t.Errorf("Error should be %v, but was %v", NotSliceErr, err)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	tr, _ := Transpose([][2]int{{1, 2}, {3, 4}, {5, 6}})
+	expect := []interface{}{
+		[]interface{}{1, 3, 5},
+		[]interface{}{2, 4, 6},
+	}
+
+	if !reflect.DeepEqual(tr, expect) {
+		t.Errorf("%v is not equal to %v", tr, expect)
+	}
+
+	if _, err := Transpose([][]int{{1, 2}, {3}}); err != UnequalLengthErr {
+		t.Errorf("Error should be %v, but was %v", UnequalLengthErr, err)
+	}
+}
+
+func TestZipOf(t *testing.T) {
+	z, _ := ZipOf(ZipPad, []int{1, 2}, []int{3})
+	if expect := [][]int{{1, 3}, {2, 0}}; !reflect.DeepEqual(z, expect) {
+		t.Errorf("%v is not equal to %v", z, expect)
+	}
+
+	tr, _ := TransposeOf([][]int{{1, 2}, {3, 4}})
+	if expect := [][]int{{1, 3}, {2, 4}}; !reflect.DeepEqual(tr, expect) {
+		t.Errorf("%v is not equal to %v", tr, expect)
+	}
+
+	pairs, _ := Zip2([]int{1, 2}, []string{"a", "b"}, ZipStrict)
+	if expect := []Pair[int, string]{{1, "a"}, {2, "b"}}; !reflect.DeepEqual(pairs, expect) {
+		t.Errorf("%v is not equal to %v", pairs, expect)
+	}
+
+	ints, strings := Unzip2(pairs)
+	if !reflect.DeepEqual(ints, []int{1, 2}) || !reflect.DeepEqual(strings, []string{"a", "b"}) {
+		t.Errorf("%v and %v are not equal to [1 2] and [a b]", ints, strings)
+	}
+
+	if _, err := Zip2([]int{1}, []string{}, ZipStrict); err != UnequalLengthErr {
+		t.Errorf("Error should be %v, but was %v", UnequalLengthErr, err)
+	}
+}